@@ -0,0 +1,5 @@
+// Package blockchainv1 contains the protobuf messages and gRPC services of the
+// blockchain data API, generated from the .proto files in this directory
+package blockchainv1
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative --go-grpc_out=../.. --go-grpc_opt=paths=source_relative blockchain/v1/common.proto blockchain/v1/event.proto blockchain/v1/validator.proto
@@ -0,0 +1,1720 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v4.25.3
+// source: blockchain/v1/validator.proto
+
+package blockchainv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListValidatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page *PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListValidatorsRequest) Reset() {
+	*x = ListValidatorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListValidatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValidatorsRequest) ProtoMessage() {}
+
+func (x *ListValidatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*ListValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListValidatorsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListValidatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators []*Validator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	PageInfo   *PageInfo    `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+}
+
+func (x *ListValidatorsResponse) Reset() {
+	*x = ListValidatorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListValidatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValidatorsResponse) ProtoMessage() {}
+
+func (x *ListValidatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*ListValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListValidatorsResponse) GetValidators() []*Validator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *ListValidatorsResponse) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type GetValidatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *GetValidatorRequest) Reset() {
+	*x = GetValidatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorRequest) ProtoMessage() {}
+
+func (x *GetValidatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorRequest) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetValidatorRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ListValidatorEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  string       `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Query *EventQuery  `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Page  *PageRequest `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListValidatorEventsRequest) Reset() {
+	*x = ListValidatorEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListValidatorEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValidatorEventsRequest) ProtoMessage() {}
+
+func (x *ListValidatorEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValidatorEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListValidatorEventsRequest) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListValidatorEventsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListValidatorEventsRequest) GetQuery() *EventQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ListValidatorEventsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// Validator is a blockchain validator, events are listed separately
+type Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stash       string                 `protobuf:"bytes,1,opt,name=stash,proto3" json:"stash,omitempty"`
+	Type        string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	EventCount  int32                  `protobuf:"varint,4,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Validator) Reset() {
+	*x = Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validator) ProtoMessage() {}
+
+func (x *Validator) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
+func (*Validator) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Validator) GetStash() string {
+	if x != nil {
+		return x.Stash
+	}
+	return ""
+}
+
+func (x *Validator) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Validator) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Validator) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *Validator) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Validator) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ValidatorStats are statistics for a validator
+type ValidatorStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalEvents      int32                   `protobuf:"varint,1,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	StakingEvents    int32                   `protobuf:"varint,2,opt,name=staking_events,json=stakingEvents,proto3" json:"staking_events,omitempty"`
+	GovernanceEvents int32                   `protobuf:"varint,3,opt,name=governance_events,json=governanceEvents,proto3" json:"governance_events,omitempty"`
+	OnlineEvents     int32                   `protobuf:"varint,4,opt,name=online_events,json=onlineEvents,proto3" json:"online_events,omitempty"`
+	OffenceEvents    int32                   `protobuf:"varint,5,opt,name=offence_events,json=offenceEvents,proto3" json:"offence_events,omitempty"`
+	TotalRewards     int64                   `protobuf:"varint,6,opt,name=total_rewards,json=totalRewards,proto3" json:"total_rewards,omitempty"`
+	IsActive         bool                    `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	HasBeenSlashed   bool                    `protobuf:"varint,8,opt,name=has_been_slashed,json=hasBeenSlashed,proto3" json:"has_been_slashed,omitempty"`
+	Payouts          *PayoutSummary          `protobuf:"bytes,9,opt,name=payouts,proto3" json:"payouts,omitempty"`
+	StakeState       string                  `protobuf:"bytes,10,opt,name=stake_state,json=stakeState,proto3" json:"stake_state,omitempty"`
+	BondedStake      int64                   `protobuf:"varint,11,opt,name=bonded_stake,json=bondedStake,proto3" json:"bonded_stake,omitempty"`
+	OffencesByKind   map[string]int32        `protobuf:"bytes,12,rep,name=offences_by_kind,json=offencesByKind,proto3" json:"offences_by_kind,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Consensus        *ConsensusParticipation `protobuf:"bytes,13,opt,name=consensus,proto3" json:"consensus,omitempty"`
+}
+
+func (x *ValidatorStats) Reset() {
+	*x = ValidatorStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStats) ProtoMessage() {}
+
+func (x *ValidatorStats) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStats.ProtoReflect.Descriptor instead.
+func (*ValidatorStats) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValidatorStats) GetTotalEvents() int32 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetStakingEvents() int32 {
+	if x != nil {
+		return x.StakingEvents
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetGovernanceEvents() int32 {
+	if x != nil {
+		return x.GovernanceEvents
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetOnlineEvents() int32 {
+	if x != nil {
+		return x.OnlineEvents
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetOffenceEvents() int32 {
+	if x != nil {
+		return x.OffenceEvents
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetTotalRewards() int64 {
+	if x != nil {
+		return x.TotalRewards
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ValidatorStats) GetHasBeenSlashed() bool {
+	if x != nil {
+		return x.HasBeenSlashed
+	}
+	return false
+}
+
+func (x *ValidatorStats) GetPayouts() *PayoutSummary {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *ValidatorStats) GetStakeState() string {
+	if x != nil {
+		return x.StakeState
+	}
+	return ""
+}
+
+func (x *ValidatorStats) GetBondedStake() int64 {
+	if x != nil {
+		return x.BondedStake
+	}
+	return 0
+}
+
+func (x *ValidatorStats) GetOffencesByKind() map[string]int32 {
+	if x != nil {
+		return x.OffencesByKind
+	}
+	return nil
+}
+
+func (x *ValidatorStats) GetConsensus() *ConsensusParticipation {
+	if x != nil {
+		return x.Consensus
+	}
+	return nil
+}
+
+// ConsensusParticipation summarizes the BABE epochs a validator took part in
+type ConsensusParticipation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochsInAuthoritySet int32  `protobuf:"varint,1,opt,name=epochs_in_authority_set,json=epochsInAuthoritySet,proto3" json:"epochs_in_authority_set,omitempty"`
+	EpochsFinalized      int32  `protobuf:"varint,2,opt,name=epochs_finalized,json=epochsFinalized,proto3" json:"epochs_finalized,omitempty"`
+	FirstEpoch           *int32 `protobuf:"varint,3,opt,name=first_epoch,json=firstEpoch,proto3,oneof" json:"first_epoch,omitempty"`
+	LastEpoch            *int32 `protobuf:"varint,4,opt,name=last_epoch,json=lastEpoch,proto3,oneof" json:"last_epoch,omitempty"`
+	LastEpochBlock       int64  `protobuf:"varint,5,opt,name=last_epoch_block,json=lastEpochBlock,proto3" json:"last_epoch_block,omitempty"`
+	AuthoritySetChanges  int32  `protobuf:"varint,6,opt,name=authority_set_changes,json=authoritySetChanges,proto3" json:"authority_set_changes,omitempty"`
+	BlocksAuthored       int32  `protobuf:"varint,7,opt,name=blocks_authored,json=blocksAuthored,proto3" json:"blocks_authored,omitempty"`
+}
+
+func (x *ConsensusParticipation) Reset() {
+	*x = ConsensusParticipation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsensusParticipation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusParticipation) ProtoMessage() {}
+
+func (x *ConsensusParticipation) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusParticipation.ProtoReflect.Descriptor instead.
+func (*ConsensusParticipation) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConsensusParticipation) GetEpochsInAuthoritySet() int32 {
+	if x != nil {
+		return x.EpochsInAuthoritySet
+	}
+	return 0
+}
+
+func (x *ConsensusParticipation) GetEpochsFinalized() int32 {
+	if x != nil {
+		return x.EpochsFinalized
+	}
+	return 0
+}
+
+func (x *ConsensusParticipation) GetFirstEpoch() int32 {
+	if x != nil && x.FirstEpoch != nil {
+		return *x.FirstEpoch
+	}
+	return 0
+}
+
+func (x *ConsensusParticipation) GetLastEpoch() int32 {
+	if x != nil && x.LastEpoch != nil {
+		return *x.LastEpoch
+	}
+	return 0
+}
+
+func (x *ConsensusParticipation) GetLastEpochBlock() int64 {
+	if x != nil {
+		return x.LastEpochBlock
+	}
+	return 0
+}
+
+func (x *ConsensusParticipation) GetAuthoritySetChanges() int32 {
+	if x != nil {
+		return x.AuthoritySetChanges
+	}
+	return 0
+}
+
+func (x *ConsensusParticipation) GetBlocksAuthored() int32 {
+	if x != nil {
+		return x.BlocksAuthored
+	}
+	return 0
+}
+
+// PayoutReport is the era payout reconciliation of a validator
+type PayoutReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stash               string         `protobuf:"bytes,1,opt,name=stash,proto3" json:"stash,omitempty"`
+	LateThresholdBlocks int32          `protobuf:"varint,2,opt,name=late_threshold_blocks,json=lateThresholdBlocks,proto3" json:"late_threshold_blocks,omitempty"`
+	Eras                []*EraPayout   `protobuf:"bytes,3,rep,name=eras,proto3" json:"eras,omitempty"`
+	Issues              []*PayoutIssue `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+	Summary             *PayoutSummary `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *PayoutReport) Reset() {
+	*x = PayoutReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutReport) ProtoMessage() {}
+
+func (x *PayoutReport) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutReport.ProtoReflect.Descriptor instead.
+func (*PayoutReport) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PayoutReport) GetStash() string {
+	if x != nil {
+		return x.Stash
+	}
+	return ""
+}
+
+func (x *PayoutReport) GetLateThresholdBlocks() int32 {
+	if x != nil {
+		return x.LateThresholdBlocks
+	}
+	return 0
+}
+
+func (x *PayoutReport) GetEras() []*EraPayout {
+	if x != nil {
+		return x.Eras
+	}
+	return nil
+}
+
+func (x *PayoutReport) GetIssues() []*PayoutIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *PayoutReport) GetSummary() *PayoutSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// EraPayout is the payout lifecycle of a validator for a single era
+type EraPayout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Era                int32  `protobuf:"varint,1,opt,name=era,proto3" json:"era,omitempty"`
+	Status             string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Elected            bool   `protobuf:"varint,3,opt,name=elected,proto3" json:"elected,omitempty"`
+	EraPaidBlock       int64  `protobuf:"varint,4,opt,name=era_paid_block,json=eraPaidBlock,proto3" json:"era_paid_block,omitempty"`
+	PayoutStartedBlock int64  `protobuf:"varint,5,opt,name=payout_started_block,json=payoutStartedBlock,proto3" json:"payout_started_block,omitempty"`
+	RewardedBlock      int64  `protobuf:"varint,6,opt,name=rewarded_block,json=rewardedBlock,proto3" json:"rewarded_block,omitempty"`
+	Amount             int64  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	DelayBlocks        int64  `protobuf:"varint,8,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+	Late               bool   `protobuf:"varint,9,opt,name=late,proto3" json:"late,omitempty"`
+}
+
+func (x *EraPayout) Reset() {
+	*x = EraPayout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EraPayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraPayout) ProtoMessage() {}
+
+func (x *EraPayout) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraPayout.ProtoReflect.Descriptor instead.
+func (*EraPayout) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EraPayout) GetEra() int32 {
+	if x != nil {
+		return x.Era
+	}
+	return 0
+}
+
+func (x *EraPayout) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EraPayout) GetElected() bool {
+	if x != nil {
+		return x.Elected
+	}
+	return false
+}
+
+func (x *EraPayout) GetEraPaidBlock() int64 {
+	if x != nil {
+		return x.EraPaidBlock
+	}
+	return 0
+}
+
+func (x *EraPayout) GetPayoutStartedBlock() int64 {
+	if x != nil {
+		return x.PayoutStartedBlock
+	}
+	return 0
+}
+
+func (x *EraPayout) GetRewardedBlock() int64 {
+	if x != nil {
+		return x.RewardedBlock
+	}
+	return 0
+}
+
+func (x *EraPayout) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EraPayout) GetDelayBlocks() int64 {
+	if x != nil {
+		return x.DelayBlocks
+	}
+	return 0
+}
+
+func (x *EraPayout) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+// PayoutIssue is a single finding of the payout reconciliation
+type PayoutIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Era         int32  `protobuf:"varint,1,opt,name=era,proto3" json:"era,omitempty"`
+	Kind        string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Block       int64  `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *PayoutIssue) Reset() {
+	*x = PayoutIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutIssue) ProtoMessage() {}
+
+func (x *PayoutIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutIssue.ProtoReflect.Descriptor instead.
+func (*PayoutIssue) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PayoutIssue) GetEra() int32 {
+	if x != nil {
+		return x.Era
+	}
+	return 0
+}
+
+func (x *PayoutIssue) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PayoutIssue) GetBlock() int64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *PayoutIssue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// PayoutSummary aggregates payout reconciliation counters
+type PayoutSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErasTracked     int32 `protobuf:"varint,1,opt,name=eras_tracked,json=erasTracked,proto3" json:"eras_tracked,omitempty"`
+	RewardedEras    int32 `protobuf:"varint,2,opt,name=rewarded_eras,json=rewardedEras,proto3" json:"rewarded_eras,omitempty"`
+	UnrewardedEras  int32 `protobuf:"varint,3,opt,name=unrewarded_eras,json=unrewardedEras,proto3" json:"unrewarded_eras,omitempty"`
+	MissingPayouts  int32 `protobuf:"varint,4,opt,name=missing_payouts,json=missingPayouts,proto3" json:"missing_payouts,omitempty"`
+	LatePayouts     int32 `protobuf:"varint,5,opt,name=late_payouts,json=latePayouts,proto3" json:"late_payouts,omitempty"`
+	SkippedEras     int32 `protobuf:"varint,6,opt,name=skipped_eras,json=skippedEras,proto3" json:"skipped_eras,omitempty"`
+	TotalPaidAmount int64 `protobuf:"varint,7,opt,name=total_paid_amount,json=totalPaidAmount,proto3" json:"total_paid_amount,omitempty"`
+}
+
+func (x *PayoutSummary) Reset() {
+	*x = PayoutSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutSummary) ProtoMessage() {}
+
+func (x *PayoutSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutSummary.ProtoReflect.Descriptor instead.
+func (*PayoutSummary) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PayoutSummary) GetErasTracked() int32 {
+	if x != nil {
+		return x.ErasTracked
+	}
+	return 0
+}
+
+func (x *PayoutSummary) GetRewardedEras() int32 {
+	if x != nil {
+		return x.RewardedEras
+	}
+	return 0
+}
+
+func (x *PayoutSummary) GetUnrewardedEras() int32 {
+	if x != nil {
+		return x.UnrewardedEras
+	}
+	return 0
+}
+
+func (x *PayoutSummary) GetMissingPayouts() int32 {
+	if x != nil {
+		return x.MissingPayouts
+	}
+	return 0
+}
+
+func (x *PayoutSummary) GetLatePayouts() int32 {
+	if x != nil {
+		return x.LatePayouts
+	}
+	return 0
+}
+
+func (x *PayoutSummary) GetSkippedEras() int32 {
+	if x != nil {
+		return x.SkippedEras
+	}
+	return 0
+}
+
+func (x *PayoutSummary) GetTotalPaidAmount() int64 {
+	if x != nil {
+		return x.TotalPaidAmount
+	}
+	return 0
+}
+
+// StakeLedger is the bonded balance timeline and lifecycle of a stash
+type StakeLedger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stash       string             `protobuf:"bytes,1,opt,name=stash,proto3" json:"stash,omitempty"`
+	State       string             `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Bonded      int64              `protobuf:"varint,3,opt,name=bonded,proto3" json:"bonded,omitempty"`
+	Unbonding   int64              `protobuf:"varint,4,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
+	Withdrawn   int64              `protobuf:"varint,5,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	Slashed     int64              `protobuf:"varint,6,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	Timeline    []*StakeEntry      `protobuf:"bytes,7,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	Transitions []*StakeTransition `protobuf:"bytes,8,rep,name=transitions,proto3" json:"transitions,omitempty"`
+}
+
+func (x *StakeLedger) Reset() {
+	*x = StakeLedger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakeLedger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeLedger) ProtoMessage() {}
+
+func (x *StakeLedger) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakeLedger.ProtoReflect.Descriptor instead.
+func (*StakeLedger) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StakeLedger) GetStash() string {
+	if x != nil {
+		return x.Stash
+	}
+	return ""
+}
+
+func (x *StakeLedger) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StakeLedger) GetBonded() int64 {
+	if x != nil {
+		return x.Bonded
+	}
+	return 0
+}
+
+func (x *StakeLedger) GetUnbonding() int64 {
+	if x != nil {
+		return x.Unbonding
+	}
+	return 0
+}
+
+func (x *StakeLedger) GetWithdrawn() int64 {
+	if x != nil {
+		return x.Withdrawn
+	}
+	return 0
+}
+
+func (x *StakeLedger) GetSlashed() int64 {
+	if x != nil {
+		return x.Slashed
+	}
+	return 0
+}
+
+func (x *StakeLedger) GetTimeline() []*StakeEntry {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *StakeLedger) GetTransitions() []*StakeTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+// StakeEntry holds the stake balances right after a lifecycle event
+type StakeEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block     int64  `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Event     string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Delta     int64  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Bonded    int64  `protobuf:"varint,4,opt,name=bonded,proto3" json:"bonded,omitempty"`
+	Unbonding int64  `protobuf:"varint,5,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
+	Withdrawn int64  `protobuf:"varint,6,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	Slashed   int64  `protobuf:"varint,7,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	State     string `protobuf:"bytes,8,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *StakeEntry) Reset() {
+	*x = StakeEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeEntry) ProtoMessage() {}
+
+func (x *StakeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakeEntry.ProtoReflect.Descriptor instead.
+func (*StakeEntry) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StakeEntry) GetBlock() int64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *StakeEntry) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *StakeEntry) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StakeEntry) GetBonded() int64 {
+	if x != nil {
+		return x.Bonded
+	}
+	return 0
+}
+
+func (x *StakeEntry) GetUnbonding() int64 {
+	if x != nil {
+		return x.Unbonding
+	}
+	return 0
+}
+
+func (x *StakeEntry) GetWithdrawn() int64 {
+	if x != nil {
+		return x.Withdrawn
+	}
+	return 0
+}
+
+func (x *StakeEntry) GetSlashed() int64 {
+	if x != nil {
+		return x.Slashed
+	}
+	return 0
+}
+
+func (x *StakeEntry) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// StakeTransition is a change of the stash lifecycle state
+type StakeTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block int64  `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Event string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	From  string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To    string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *StakeTransition) Reset() {
+	*x = StakeTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_v1_validator_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakeTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeTransition) ProtoMessage() {}
+
+func (x *StakeTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_v1_validator_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakeTransition.ProtoReflect.Descriptor instead.
+func (*StakeTransition) Descriptor() ([]byte, []int) {
+	return file_blockchain_v1_validator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StakeTransition) GetBlock() int64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *StakeTransition) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *StakeTransition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StakeTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+var File_blockchain_v1_validator_proto protoreflect.FileDescriptor
+
+var file_blockchain_v1_validator_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2e,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xee,
+	0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xa0, 0x05, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x62,
+	0x65, 0x65, 0x6e, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x42, 0x65, 0x65, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f,
+	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x5b, 0x0a,
+	0x10, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42,
+	0x79, 0x4b, 0x69, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x43, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x1a,
+	0x41, 0x0a, 0x13, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4b, 0x69, 0x6e,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xea, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a,
+	0x17, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x49, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x53, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12,
+	0x24, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x53, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x65, 0x64,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22,
+	0xf2, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x65, 0x72,
+	0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x61, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x52, 0x04, 0x65, 0x72, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x22, 0x9d, 0x02, 0x0a, 0x09, 0x45, 0x72, 0x61, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x65, 0x72, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x61, 0x5f, 0x70, 0x61,
+	0x69, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x65, 0x72, 0x61, 0x50, 0x61, 0x69, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x14,
+	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x6c, 0x61, 0x74, 0x65, 0x22, 0x6b, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x65, 0x72, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x9b, 0x02, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x72, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x72, 0x61, 0x73, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x64, 0x5f, 0x65, 0x72, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x45, 0x72, 0x61, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x75,
+	0x6e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x61, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x75, 0x6e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x45, 0x72, 0x61, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x61, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45,
+	0x72, 0x61, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x69,
+	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xa0, 0x02, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x6f, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6f, 0x6e,
+	0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x40, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x61, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x32, 0xa0, 0x04, 0x0a, 0x10, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x5d, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x24, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x5a, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x22, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x56, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x22, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x42, 0x2c, 0x5a,
+	0x2a, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_blockchain_v1_validator_proto_rawDescOnce sync.Once
+	file_blockchain_v1_validator_proto_rawDescData = file_blockchain_v1_validator_proto_rawDesc
+)
+
+func file_blockchain_v1_validator_proto_rawDescGZIP() []byte {
+	file_blockchain_v1_validator_proto_rawDescOnce.Do(func() {
+		file_blockchain_v1_validator_proto_rawDescData = protoimpl.X.CompressGZIP(file_blockchain_v1_validator_proto_rawDescData)
+	})
+	return file_blockchain_v1_validator_proto_rawDescData
+}
+
+var file_blockchain_v1_validator_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_blockchain_v1_validator_proto_goTypes = []interface{}{
+	(*ListValidatorsRequest)(nil),      // 0: blockchain.v1.ListValidatorsRequest
+	(*ListValidatorsResponse)(nil),     // 1: blockchain.v1.ListValidatorsResponse
+	(*GetValidatorRequest)(nil),        // 2: blockchain.v1.GetValidatorRequest
+	(*ListValidatorEventsRequest)(nil), // 3: blockchain.v1.ListValidatorEventsRequest
+	(*Validator)(nil),                  // 4: blockchain.v1.Validator
+	(*ValidatorStats)(nil),             // 5: blockchain.v1.ValidatorStats
+	(*ConsensusParticipation)(nil),     // 6: blockchain.v1.ConsensusParticipation
+	(*PayoutReport)(nil),               // 7: blockchain.v1.PayoutReport
+	(*EraPayout)(nil),                  // 8: blockchain.v1.EraPayout
+	(*PayoutIssue)(nil),                // 9: blockchain.v1.PayoutIssue
+	(*PayoutSummary)(nil),              // 10: blockchain.v1.PayoutSummary
+	(*StakeLedger)(nil),                // 11: blockchain.v1.StakeLedger
+	(*StakeEntry)(nil),                 // 12: blockchain.v1.StakeEntry
+	(*StakeTransition)(nil),            // 13: blockchain.v1.StakeTransition
+	nil,                                // 14: blockchain.v1.ValidatorStats.OffencesByKindEntry
+	(*PageRequest)(nil),                // 15: blockchain.v1.PageRequest
+	(*PageInfo)(nil),                   // 16: blockchain.v1.PageInfo
+	(*EventQuery)(nil),                 // 17: blockchain.v1.EventQuery
+	(*timestamppb.Timestamp)(nil),      // 18: google.protobuf.Timestamp
+	(*EventPage)(nil),                  // 19: blockchain.v1.EventPage
+}
+var file_blockchain_v1_validator_proto_depIdxs = []int32{
+	15, // 0: blockchain.v1.ListValidatorsRequest.page:type_name -> blockchain.v1.PageRequest
+	4,  // 1: blockchain.v1.ListValidatorsResponse.validators:type_name -> blockchain.v1.Validator
+	16, // 2: blockchain.v1.ListValidatorsResponse.page_info:type_name -> blockchain.v1.PageInfo
+	17, // 3: blockchain.v1.ListValidatorEventsRequest.query:type_name -> blockchain.v1.EventQuery
+	15, // 4: blockchain.v1.ListValidatorEventsRequest.page:type_name -> blockchain.v1.PageRequest
+	18, // 5: blockchain.v1.Validator.created_at:type_name -> google.protobuf.Timestamp
+	18, // 6: blockchain.v1.Validator.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 7: blockchain.v1.ValidatorStats.payouts:type_name -> blockchain.v1.PayoutSummary
+	14, // 8: blockchain.v1.ValidatorStats.offences_by_kind:type_name -> blockchain.v1.ValidatorStats.OffencesByKindEntry
+	6,  // 9: blockchain.v1.ValidatorStats.consensus:type_name -> blockchain.v1.ConsensusParticipation
+	8,  // 10: blockchain.v1.PayoutReport.eras:type_name -> blockchain.v1.EraPayout
+	9,  // 11: blockchain.v1.PayoutReport.issues:type_name -> blockchain.v1.PayoutIssue
+	10, // 12: blockchain.v1.PayoutReport.summary:type_name -> blockchain.v1.PayoutSummary
+	12, // 13: blockchain.v1.StakeLedger.timeline:type_name -> blockchain.v1.StakeEntry
+	13, // 14: blockchain.v1.StakeLedger.transitions:type_name -> blockchain.v1.StakeTransition
+	0,  // 15: blockchain.v1.ValidatorService.ListValidators:input_type -> blockchain.v1.ListValidatorsRequest
+	2,  // 16: blockchain.v1.ValidatorService.GetValidator:input_type -> blockchain.v1.GetValidatorRequest
+	3,  // 17: blockchain.v1.ValidatorService.ListValidatorEvents:input_type -> blockchain.v1.ListValidatorEventsRequest
+	2,  // 18: blockchain.v1.ValidatorService.GetValidatorStats:input_type -> blockchain.v1.GetValidatorRequest
+	2,  // 19: blockchain.v1.ValidatorService.GetValidatorPayouts:input_type -> blockchain.v1.GetValidatorRequest
+	2,  // 20: blockchain.v1.ValidatorService.GetValidatorStake:input_type -> blockchain.v1.GetValidatorRequest
+	1,  // 21: blockchain.v1.ValidatorService.ListValidators:output_type -> blockchain.v1.ListValidatorsResponse
+	4,  // 22: blockchain.v1.ValidatorService.GetValidator:output_type -> blockchain.v1.Validator
+	19, // 23: blockchain.v1.ValidatorService.ListValidatorEvents:output_type -> blockchain.v1.EventPage
+	5,  // 24: blockchain.v1.ValidatorService.GetValidatorStats:output_type -> blockchain.v1.ValidatorStats
+	7,  // 25: blockchain.v1.ValidatorService.GetValidatorPayouts:output_type -> blockchain.v1.PayoutReport
+	11, // 26: blockchain.v1.ValidatorService.GetValidatorStake:output_type -> blockchain.v1.StakeLedger
+	21, // [21:27] is the sub-list for method output_type
+	15, // [15:21] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_blockchain_v1_validator_proto_init() }
+func file_blockchain_v1_validator_proto_init() {
+	if File_blockchain_v1_validator_proto != nil {
+		return
+	}
+	file_blockchain_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_blockchain_v1_validator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListValidatorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListValidatorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListValidatorEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Validator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsensusParticipation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraPayout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeLedger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_v1_validator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_blockchain_v1_validator_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_blockchain_v1_validator_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_blockchain_v1_validator_proto_goTypes,
+		DependencyIndexes: file_blockchain_v1_validator_proto_depIdxs,
+		MessageInfos:      file_blockchain_v1_validator_proto_msgTypes,
+	}.Build()
+	File_blockchain_v1_validator_proto = out.File
+	file_blockchain_v1_validator_proto_rawDesc = nil
+	file_blockchain_v1_validator_proto_goTypes = nil
+	file_blockchain_v1_validator_proto_depIdxs = nil
+}
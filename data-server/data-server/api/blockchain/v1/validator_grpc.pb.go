@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             v4.25.3
+// source: blockchain/v1/validator.proto
+
+package blockchainv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	ValidatorService_ListValidators_FullMethodName      = "/blockchain.v1.ValidatorService/ListValidators"
+	ValidatorService_GetValidator_FullMethodName        = "/blockchain.v1.ValidatorService/GetValidator"
+	ValidatorService_ListValidatorEvents_FullMethodName = "/blockchain.v1.ValidatorService/ListValidatorEvents"
+	ValidatorService_GetValidatorStats_FullMethodName   = "/blockchain.v1.ValidatorService/GetValidatorStats"
+	ValidatorService_GetValidatorPayouts_FullMethodName = "/blockchain.v1.ValidatorService/GetValidatorPayouts"
+	ValidatorService_GetValidatorStake_FullMethodName   = "/blockchain.v1.ValidatorService/GetValidatorStake"
+)
+
+// ValidatorServiceClient is the client API for ValidatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ValidatorService serves validators, their events and analytics
+type ValidatorServiceClient interface {
+	// ListValidators returns a page of all validators
+	ListValidators(ctx context.Context, in *ListValidatorsRequest, opts ...grpc.CallOption) (*ListValidatorsResponse, error)
+	// GetValidator returns a validator by its type (good, neutral, bad)
+	GetValidator(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*Validator, error)
+	// ListValidatorEvents returns a page of the validator events matching the query
+	ListValidatorEvents(ctx context.Context, in *ListValidatorEventsRequest, opts ...grpc.CallOption) (*EventPage, error)
+	// GetValidatorStats returns statistics for a validator
+	GetValidatorStats(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*ValidatorStats, error)
+	// GetValidatorPayouts returns the era payout reconciliation of a validator
+	GetValidatorPayouts(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*PayoutReport, error)
+	// GetValidatorStake returns the stake ledger and lifecycle of a validator
+	GetValidatorStake(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*StakeLedger, error)
+}
+
+type validatorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewValidatorServiceClient(cc grpc.ClientConnInterface) ValidatorServiceClient {
+	return &validatorServiceClient{cc}
+}
+
+func (c *validatorServiceClient) ListValidators(ctx context.Context, in *ListValidatorsRequest, opts ...grpc.CallOption) (*ListValidatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListValidatorsResponse)
+	err := c.cc.Invoke(ctx, ValidatorService_ListValidators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) GetValidator(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*Validator, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Validator)
+	err := c.cc.Invoke(ctx, ValidatorService_GetValidator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ListValidatorEvents(ctx context.Context, in *ListValidatorEventsRequest, opts ...grpc.CallOption) (*EventPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventPage)
+	err := c.cc.Invoke(ctx, ValidatorService_ListValidatorEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) GetValidatorStats(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*ValidatorStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatorStats)
+	err := c.cc.Invoke(ctx, ValidatorService_GetValidatorStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) GetValidatorPayouts(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*PayoutReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayoutReport)
+	err := c.cc.Invoke(ctx, ValidatorService_GetValidatorPayouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) GetValidatorStake(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*StakeLedger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StakeLedger)
+	err := c.cc.Invoke(ctx, ValidatorService_GetValidatorStake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorServiceServer is the server API for ValidatorService service.
+// All implementations must embed UnimplementedValidatorServiceServer
+// for forward compatibility
+//
+// ValidatorService serves validators, their events and analytics
+type ValidatorServiceServer interface {
+	// ListValidators returns a page of all validators
+	ListValidators(context.Context, *ListValidatorsRequest) (*ListValidatorsResponse, error)
+	// GetValidator returns a validator by its type (good, neutral, bad)
+	GetValidator(context.Context, *GetValidatorRequest) (*Validator, error)
+	// ListValidatorEvents returns a page of the validator events matching the query
+	ListValidatorEvents(context.Context, *ListValidatorEventsRequest) (*EventPage, error)
+	// GetValidatorStats returns statistics for a validator
+	GetValidatorStats(context.Context, *GetValidatorRequest) (*ValidatorStats, error)
+	// GetValidatorPayouts returns the era payout reconciliation of a validator
+	GetValidatorPayouts(context.Context, *GetValidatorRequest) (*PayoutReport, error)
+	// GetValidatorStake returns the stake ledger and lifecycle of a validator
+	GetValidatorStake(context.Context, *GetValidatorRequest) (*StakeLedger, error)
+	mustEmbedUnimplementedValidatorServiceServer()
+}
+
+// UnimplementedValidatorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedValidatorServiceServer struct {
+}
+
+func (UnimplementedValidatorServiceServer) ListValidators(context.Context, *ListValidatorsRequest) (*ListValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidators not implemented")
+}
+func (UnimplementedValidatorServiceServer) GetValidator(context.Context, *GetValidatorRequest) (*Validator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidator not implemented")
+}
+func (UnimplementedValidatorServiceServer) ListValidatorEvents(context.Context, *ListValidatorEventsRequest) (*EventPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorEvents not implemented")
+}
+func (UnimplementedValidatorServiceServer) GetValidatorStats(context.Context, *GetValidatorRequest) (*ValidatorStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorStats not implemented")
+}
+func (UnimplementedValidatorServiceServer) GetValidatorPayouts(context.Context, *GetValidatorRequest) (*PayoutReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPayouts not implemented")
+}
+func (UnimplementedValidatorServiceServer) GetValidatorStake(context.Context, *GetValidatorRequest) (*StakeLedger, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorStake not implemented")
+}
+func (UnimplementedValidatorServiceServer) mustEmbedUnimplementedValidatorServiceServer() {}
+
+// UnsafeValidatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ValidatorServiceServer will
+// result in compilation errors.
+type UnsafeValidatorServiceServer interface {
+	mustEmbedUnimplementedValidatorServiceServer()
+}
+
+func RegisterValidatorServiceServer(s grpc.ServiceRegistrar, srv ValidatorServiceServer) {
+	s.RegisterService(&ValidatorService_ServiceDesc, srv)
+}
+
+func _ValidatorService_ListValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ListValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatorService_ListValidators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ListValidators(ctx, req.(*ListValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_GetValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).GetValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatorService_GetValidator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).GetValidator(ctx, req.(*GetValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ListValidatorEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ListValidatorEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatorService_ListValidatorEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ListValidatorEvents(ctx, req.(*ListValidatorEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_GetValidatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).GetValidatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatorService_GetValidatorStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).GetValidatorStats(ctx, req.(*GetValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_GetValidatorPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).GetValidatorPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatorService_GetValidatorPayouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).GetValidatorPayouts(ctx, req.(*GetValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_GetValidatorStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).GetValidatorStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatorService_GetValidatorStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).GetValidatorStake(ctx, req.(*GetValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ValidatorService_ServiceDesc is the grpc.ServiceDesc for ValidatorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ValidatorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blockchain.v1.ValidatorService",
+	HandlerType: (*ValidatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListValidators",
+			Handler:    _ValidatorService_ListValidators_Handler,
+		},
+		{
+			MethodName: "GetValidator",
+			Handler:    _ValidatorService_GetValidator_Handler,
+		},
+		{
+			MethodName: "ListValidatorEvents",
+			Handler:    _ValidatorService_ListValidatorEvents_Handler,
+		},
+		{
+			MethodName: "GetValidatorStats",
+			Handler:    _ValidatorService_GetValidatorStats_Handler,
+		},
+		{
+			MethodName: "GetValidatorPayouts",
+			Handler:    _ValidatorService_GetValidatorPayouts_Handler,
+		},
+		{
+			MethodName: "GetValidatorStake",
+			Handler:    _ValidatorService_GetValidatorStake_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blockchain/v1/validator.proto",
+}
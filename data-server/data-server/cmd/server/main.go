@@ -1,77 +1,430 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
 
+	blockchainv1 "data-server/api/blockchain/v1"
+	"data-server/docs"
+	"data-server/internal/adapters/input/graphql"
+	"data-server/internal/adapters/input/grpc"
 	"data-server/internal/adapters/input/http/handlers"
+	"data-server/internal/adapters/input/http/middleware"
+	"data-server/internal/adapters/input/http/openapi"
 	"data-server/internal/adapters/input/usecases"
+	"data-server/internal/adapters/output/chain"
+	"data-server/internal/adapters/output/eventbus"
+	"data-server/internal/adapters/output/file"
+	"data-server/internal/adapters/output/instrumented"
 	"data-server/internal/adapters/output/memory"
-	"data-server/pkg/response"
+	"data-server/internal/adapters/output/metrics"
+	"data-server/internal/adapters/output/tracing"
+	"data-server/internal/adapters/output/webhook"
+	"data-server/internal/config"
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/output"
+	"data-server/pkg/logger"
+	"data-server/pkg/ratelimit"
+	"data-server/pkg/version"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	grpclib "google.golang.org/grpc"
 )
 
+const (
+	// revalidateCacheControl lets clients keep validator and event responses, revalidating
+	// them on every use
+	revalidateCacheControl = "no-cache"
+
+	// analyticsCacheControl lets clients reuse aggregated analytics for a few seconds
+	analyticsCacheControl = "max-age=5, must-revalidate"
+
+	// readHeaderTimeout bounds the time a client takes to send the headers of a request
+	readHeaderTimeout = 10 * time.Second
+)
+
+// requestCosts weighs the HTTP routes and gRPC methods scanning many events and the wide
+// block ranges, in tokens of the rate limits. GraphQL queries are charged by complexity
+var requestCosts = ratelimit.Costs{
+	Operations: map[string]int{
+		"/api/v1/events/stats":             10,
+		"/api/v1/validators/:type/stats":   5,
+		"/api/v1/validators/:type/payouts": 3,
+		"/api/v1/incidents":                5,
+		"/api/v1/offences":                 5,
+		"/api/v1/extrinsics/failures":      5,
+		"/api/v1/export/events":            20,
+		"/api/v1/export/validators":        10,
+
+		blockchainv1.EventService_GetEventStats_FullMethodName:           10,
+		blockchainv1.ValidatorService_GetValidatorStats_FullMethodName:   5,
+		blockchainv1.ValidatorService_GetValidatorPayouts_FullMethodName: 3,
+	},
+	BlockRangeSpan: 10000,
+	Max:            50,
+}
+
 func main() {
-	// Get port from environment variable (Fly.io sets this)
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	// The configuration is read from the file given by -config or CONFIG_FILE, overridden
+	// by the environment variables and then by the command line flags
+	cfg, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		fatal("Failed to load configuration", err)
+	}
+
+	// Records are logged as JSON or as text, from the configured level
+	logLevel, err := logger.ParseLevel(cfg.Log.Level)
+	if err != nil {
+		fatal("Invalid log level", err)
+	}
+	baseLogger, err := logger.New(os.Stdout, logLevel, cfg.Log.Format)
+	if err != nil {
+		fatal("Invalid log format", err)
+	}
+	slog.SetDefault(baseLogger)
+
+	// Gin runs in release mode unless GIN_MODE says otherwise, requests are logged by the
+	// access log middleware
+	if os.Getenv(gin.EnvGinMode) == "" {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	// Unless API keys are required, requests without a key may read the data. The admin
+	// API key is accepted as an admin key, so the first keys can be created
+	var anonymousScopes []entities.APIKeyScope
+	if !cfg.Auth.KeysRequired {
+		anonymousScopes = []entities.APIKeyScope{entities.ScopeRead}
+	}
+
+	// Requests are rate limited per API key, or per IP address without a key. The rate
+	// limit of a key replaces the default key rate
+	rateLimitPolicy := ratelimit.Policy{
+		IP:  cfg.RateLimit.IP.Rate(),
+		Key: cfg.RateLimit.Key.Rate(),
+	}
+
+	// The ingestion lag is measured against the chain head of the configured node, when
+	// there is one
+	var chainHeadSource output.ChainHeadSource
+	if cfg.Chain.RPCURL != "" {
+		chainHeadSource = chain.NewRPCHeadSource(cfg.Chain.RPCURL)
+	}
+
+	// Initialize metrics and tracing, every repository and use case records its
+	// operations. Spans are exported to stderr with the stdout exporter, or to an
+	// OpenTelemetry collector with the otlp and otlphttp exporters
+	recorder := metrics.NewPrometheus()
+	tracerProvider, err := tracing.NewProvider(context.Background(), tracing.Config{
+		Exporter:       cfg.Tracing.Exporter,
+		ServiceName:    "blockchain-data-api",
+		ServiceVersion: version.Version,
+		SampleRatio:    cfg.Tracing.SampleRatio,
+		Writer:         os.Stderr,
+	})
+	if err != nil {
+		fatal("Failed to initialize tracing", err)
+	}
+	tracer := tracing.NewTracer(tracerProvider)
+
+	// Initialize repositories (output adapters), saved events are published on the event
+	// bus. Webhook subscriptions, alerts and API keys are persisted in the data directory
+	validatorStore := memory.NewValidatorRepository()
+	if cfg.Data.Source == config.DataSourceMemory {
+		validatorStore = memory.NewEmptyValidatorRepository()
+	}
+	eventBus := eventbus.NewBus()
+	validatorRepo, err := eventbus.NewPublishingRepository(instrumented.NewValidatorRepository(validatorStore, recorder, tracer), eventBus)
+	if err != nil {
+		fatal("Failed to initialize validator repository", err)
+	}
+	webhookStore, err := file.NewWebhookRepository(filepath.Join(cfg.Data.Dir, "webhooks.json"))
+	if err != nil {
+		fatal("Failed to initialize webhook repository", err)
+	}
+	alertStore, err := file.NewAlertRepository(filepath.Join(cfg.Data.Dir, "alerts.json"))
+	if err != nil {
+		fatal("Failed to initialize alert repository", err)
+	}
+	apiKeyStore, err := file.NewAPIKeyRepository(filepath.Join(cfg.Data.Dir, "api_keys.json"))
+	if err != nil {
+		fatal("Failed to initialize API key repository", err)
 	}
+	webhookRepo := instrumented.NewWebhookRepository(webhookStore, recorder, tracer)
+	webhookOutbox := instrumented.NewWebhookOutbox(webhookStore, recorder, tracer)
+	alertRuleRepo := instrumented.NewAlertRuleRepository(alertStore, recorder, tracer)
+	alertRepo := instrumented.NewAlertRepository(alertStore, recorder, tracer)
+	apiKeyRepo := instrumented.NewAPIKeyRepository(apiKeyStore, recorder, tracer)
+	alertRules, err := file.LoadAlertRules(cfg.Data.AlertRulesFile)
+	if err != nil {
+		fatal("Failed to load alert rules", err)
+	}
+
+	// Initialize use cases (input ports). The background workers run on the use cases,
+	// the input adapters call them through instrumented services
+	webhookUseCase := usecases.NewWebhookUseCase(webhookRepo, webhookOutbox, webhook.NewHTTPSender(), eventBus, validatorRepo)
+	alertUseCase := usecases.NewAlertUseCase(validatorRepo, alertRuleRepo, alertRepo, eventBus, alertRules)
+	apiKeyUseCase := usecases.NewAPIKeyUseCase(apiKeyRepo, cfg.Auth.AdminAPIKey)
+	ingestionUseCase := usecases.NewIngestionUseCase(validatorRepo, chainHeadSource, cfg.Health.MaxIngestionLag)
+	streamUseCase := usecases.NewStreamUseCase(validatorRepo, eventBus)
+	healthUseCase := usecases.NewHealthUseCase()
 
-	// Initialize repositories (output adapters)
-	validatorRepo := memory.NewValidatorRepository()
+	validatorService := usecases.NewInstrumentedValidatorService(usecases.NewValidatorUseCase(validatorRepo), recorder, tracer)
+	eventService := usecases.NewInstrumentedEventService(usecases.NewEventUseCase(validatorRepo, tracer), recorder, tracer)
+	incidentService := usecases.NewInstrumentedIncidentService(usecases.NewIncidentUseCase(validatorRepo), recorder, tracer)
+	offenceService := usecases.NewInstrumentedOffenceService(usecases.NewOffenceUseCase(validatorRepo), recorder, tracer)
+	extrinsicService := usecases.NewInstrumentedExtrinsicService(usecases.NewExtrinsicUseCase(validatorRepo), recorder, tracer)
+	epochService := usecases.NewInstrumentedEpochService(usecases.NewEpochUseCase(validatorRepo), recorder, tracer)
+	streamService := usecases.NewInstrumentedStreamService(streamUseCase, recorder, tracer)
+	webhookService := usecases.NewInstrumentedWebhookService(webhookUseCase, recorder, tracer)
+	alertService := usecases.NewInstrumentedAlertService(alertUseCase, recorder, tracer)
+	exportService := usecases.NewInstrumentedExportService(usecases.NewExportUseCase(validatorRepo), recorder, tracer)
+	apiKeyService := usecases.NewInstrumentedAPIKeyService(apiKeyUseCase, recorder, tracer)
+	ingestionService := usecases.NewInstrumentedIngestionService(ingestionUseCase, recorder, tracer)
+	healthService := usecases.NewInstrumentedHealthService(healthUseCase, recorder, tracer)
 
-	// Initialize use cases (input ports)
-	validatorService := usecases.NewValidatorUseCase(validatorRepo)
-	eventService := usecases.NewEventUseCase(validatorRepo)
+	// The server is unready when the validator data cannot be read, and degraded when the
+	// files cannot be written or the ingestion trails the chain too far
+	healthUseCase.Register("validator_repository", validatorStore, true)
+	healthUseCase.Register("webhook_storage", webhookStore, false)
+	healthUseCase.Register("alert_storage", alertStore, false)
+	healthUseCase.Register("api_key_storage", apiKeyStore, false)
+	healthUseCase.Register("ingestion", ingestionUseCase, false)
+
+	// Metrics of the stored data are collected at every scrape
+	if err := recorder.Register(metrics.NewDataCollector(eventService, ingestionService)); err != nil {
+		fatal("Failed to register data metrics", err)
+	}
 
 	// Initialize handlers (input adapters)
 	validatorHandler := handlers.NewValidatorHandler(validatorService)
 	eventHandler := handlers.NewEventHandler(eventService)
+	incidentHandler := handlers.NewIncidentHandler(incidentService)
+	offenceHandler := handlers.NewOffenceHandler(offenceService)
+	extrinsicHandler := handlers.NewExtrinsicHandler(extrinsicService)
+	epochHandler := handlers.NewEpochHandler(epochService)
+	streamHandler := handlers.NewStreamHandler(streamService, cfg.AllowAllOrigins(), cfg.Server.CORSOrigins)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	alertHandler := handlers.NewAlertHandler(alertService)
+	exportHandler := handlers.NewExportHandler(exportService)
+	apiKeyHandler := handlers.NewAPIKeyHandler(apiKeyService)
+	configHandler := handlers.NewConfigHandler(cfg)
+	healthHandler := handlers.NewHealthHandler(healthService)
+
+	// Initialize HTTP caching of the responses derived from the validator data, private
+	// to the client when API keys are required
+	cache := middleware.NewCache(validatorService, cfg.Auth.KeysRequired)
+
+	// Initialize rate limiting, the buckets are shared by the HTTP and gRPC APIs
+	limiter := ratelimit.NewLimiter()
+	rateLimit := middleware.NewRateLimit(limiter, rateLimitPolicy, requestCosts)
+
+	// Initialize API key authentication, charging the requests failing it to the rate limit
+	auth := middleware.NewAuth(apiKeyService, rateLimit, anonymousScopes...)
+
+	// Initialize GraphQL handler (input adapter)
+	graphqlHandler, err := graphql.NewHandler(validatorService, eventService, rateLimit)
+	if err != nil {
+		fatal("Failed to initialize GraphQL handler", err)
+	}
+
+	// Initialize gRPC server (input adapter)
+	grpcOptions := grpc.NewTracer().ServerOptions()
+	grpcOptions = append(grpcOptions, grpc.NewLogger().ServerOptions()...)
+	grpcRateLimiter := grpc.NewRateLimiter(limiter, rateLimitPolicy, requestCosts)
+	grpcOptions = append(grpcOptions, grpc.NewAuthenticator(apiKeyService, grpcRateLimiter, anonymousScopes...).ServerOptions()...)
+	grpcOptions = append(grpcOptions, grpcRateLimiter.ServerOptions()...)
+	grpcServer := grpc.NewServer(validatorService, eventService, streamService, grpcOptions...)
+
+	// The OpenAPI spec embedded in the server is served by the documentation and enforced
+	// on the requests, unless a spec file replaces it
+	specData := docs.OpenAPI
+	if cfg.Docs.SpecPath != "" {
+		specData, err = os.ReadFile(cfg.Docs.SpecPath)
+		if err != nil {
+			fatal("Failed to read OpenAPI spec", err)
+		}
+	}
+	spec, err := openapi.Load(specData)
+	if err != nil {
+		fatal("Failed to load OpenAPI spec", err)
+	}
 
 	// Initialize documentation handler
-	docsHandler, err := handlers.NewDocsHandler()
+	docsHandler := handlers.NewDocsHandler(spec.Raw(), cfg.Docs.BaseURL)
+
+	// Setup router, every route must be documented by the spec and every documented
+	// operation routed
+	r := setupRouter(cfg, spec, validatorHandler, eventHandler, incidentHandler, offenceHandler, extrinsicHandler, epochHandler, streamHandler, webhookHandler, alertHandler, exportHandler, apiKeyHandler, configHandler, healthHandler, graphqlHandler, docsHandler, cache, auth, rateLimit, recorder)
+	if err := spec.CheckRoutes(r.Routes()); err != nil {
+		fatal("Routes do not match the OpenAPI spec", err)
+	}
+
+	for _, route := range r.Routes() {
+		slog.Debug("Route registered", "method", route.Method, "path", route.Path, "handler", route.Handler)
+	}
+
+	// Stop on SIGINT or SIGTERM, a second signal kills the server immediately
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Deliver webhooks, evaluate alert rules, flush the API key usage and prune the rate
+	// limit buckets in the background
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	for _, run := range []func(context.Context){webhookUseCase.Run, alertUseCase.Run, apiKeyUseCase.Run, limiter.Run} {
+		workers.Add(1)
+		go func(run func(context.Context)) {
+			defer workers.Done()
+			run(workersCtx)
+		}(run)
+	}
+
+	serveErrors := make(chan error, 2)
+
+	grpcPort := strconv.Itoa(cfg.Server.GRPCPort)
+	grpcListener, err := net.Listen("tcp", ":"+grpcPort)
 	if err != nil {
-		log.Fatal("Failed to initialize docs handler:", err)
-	}
-
-	// Setup router
-	r := setupRouter(validatorHandler, eventHandler, docsHandler)
-
-	log.Println("Starting Blockchain Data API server on :" + port)
-	log.Println("Available endpoints:")
-	log.Println("  GET /api/v1/validators - Get all validators")
-	log.Println("  GET /api/v1/validators/:type - Get specific validator (good/neutral/bad)")
-	log.Println("  GET /api/v1/validators/:type/events - Get events for specific validator")
-	log.Println("  GET /api/v1/validators/:type/events/:eventType - Get events by type for validator")
-	log.Println("  GET /api/v1/validators/:type/events/blocks/:start/:end - Get events by block range for validator")
-	log.Println("  GET /api/v1/validators/:type/stats - Get validator statistics")
-	log.Println("  GET /api/v1/events - Get all events")
-	log.Println("  GET /api/v1/events/:eventType - Get events by event type")
-	log.Println("  GET /api/v1/events/blocks/:start/:end - Get events by block range")
-	log.Println("  GET /api/v1/events/category/:category - Get events by category")
-	log.Println("  GET /api/v1/events/validator/:stash - Get events by validator")
-	log.Println("  GET /api/v1/events/stats - Get event statistics")
-	log.Println("  GET /api/v1/health - Health check")
-	log.Println("  GET /docs - Interactive API documentation")
-	log.Println("  GET /docs/openapi.yaml - Raw OpenAPI specification")
-
-	if err := r.Run(":" + port); err != nil {
-		log.Fatal("Failed to start server:", err)
+		fatal("Failed to listen for gRPC", err)
 	}
+	go func() {
+		slog.Info("Starting gRPC server", "port", grpcPort, "services", []string{"blockchain.v1.ValidatorService", "blockchain.v1.EventService"})
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			serveErrors <- fmt.Errorf("gRPC server: %w", err)
+		}
+	}()
+
+	port := strconv.Itoa(cfg.Server.Port)
+	server := &http.Server{
+		Addr:              ":" + port,
+		Handler:           r,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		build := version.Get()
+		slog.Info("Starting Blockchain Data API server", "port", port, "routes", len(r.Routes()), "config_file", cfg.File, "validate_responses", cfg.Docs.ValidateResponses, "version", build.Version, "commit", build.Commit)
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			serveErrors <- fmt.Errorf("HTTP server: %w", err)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+	case err := <-serveErrors:
+		fatal("Failed to serve", err)
+	}
+	stop()
+
+	shutdown(cfg, healthUseCase, streamUseCase, server, grpcServer, stopWorkers, &workers, tracerProvider)
 }
 
-func setupRouter(validatorHandler *handlers.ValidatorHandler, eventHandler *handlers.EventHandler, docsHandler *handlers.DocsHandler) *gin.Engine {
-	r := gin.Default()
+// shutdown stops the server. The server reports itself unready for the drain delay, then
+// ends the event streams and drains the in-flight requests and calls within the shutdown
+// timeout, after which the background workers stop and the buffered spans are exported
+func shutdown(cfg *config.Config, healthUseCase *usecases.HealthUseCase, streamUseCase *usecases.StreamUseCase, server *http.Server, grpcServer *grpclib.Server, stopWorkers context.CancelFunc, workers *sync.WaitGroup, tracerProvider *sdktrace.TracerProvider) {
+	delay, timeout := time.Duration(cfg.Server.DrainDelay), time.Duration(cfg.Server.ShutdownTimeout)
+	slog.Info("Shutting down", "drain_delay", delay.String(), "timeout", timeout.String())
+
+	healthUseCase.Drain()
+	time.Sleep(delay)
 
-	// CORS configuration
-	config := cors.DefaultConfig()
-	config.AllowAllOrigins = true
-	config.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
-	config.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization"}
-	r.Use(cors.New(config))
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	streamUseCase.Close()
+
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+	if err := server.Shutdown(ctx); err != nil {
+		slog.Error("Failed to drain HTTP requests", "error", err)
+	}
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		slog.Error("Failed to drain gRPC calls", "error", ctx.Err())
+		grpcServer.Stop()
+	}
+
+	// The workers stop after the requests, so the API key usage they counted is flushed
+	stopWorkers()
+	workersStopped := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workersStopped)
+	}()
+	select {
+	case <-workersStopped:
+	case <-ctx.Done():
+		slog.Error("Failed to stop background workers", "error", ctx.Err())
+	}
+
+	if err := tracerProvider.Shutdown(ctx); err != nil {
+		slog.Error("Failed to export spans", "error", err)
+	}
+	slog.Info("Server stopped")
+}
+
+func setupRouter(cfg *config.Config, spec *openapi.Spec, validatorHandler *handlers.ValidatorHandler, eventHandler *handlers.EventHandler, incidentHandler *handlers.IncidentHandler, offenceHandler *handlers.OffenceHandler, extrinsicHandler *handlers.ExtrinsicHandler, epochHandler *handlers.EpochHandler, streamHandler *handlers.StreamHandler, webhookHandler *handlers.WebhookHandler, alertHandler *handlers.AlertHandler, exportHandler *handlers.ExportHandler, apiKeyHandler *handlers.APIKeyHandler, configHandler *handlers.ConfigHandler, healthHandler *handlers.HealthHandler, graphqlHandler *graphql.Handler, docsHandler *handlers.DocsHandler, cache *middleware.Cache, auth *middleware.Auth, rateLimit *middleware.RateLimit, recorder *metrics.Prometheus) *gin.Engine {
+	r := gin.New()
+
+	// The client IP, which rate limits the clients without API key, is only read from the
+	// headers of the trusted platform and proxies
+	if err := middleware.TrustProxies(r, cfg.Server.TrustedPlatform, cfg.Server.TrustedProxies); err != nil {
+		fatal("Failed to trust proxies", err)
+	}
+
+	// Unknown routes and methods get problem details like every other error
+	r.HandleMethodNotAllowed = true
+	r.NoRoute(handlers.RouteNotFound)
+	r.NoMethod(handlers.MethodNotAllowed)
+
+	// Every request is traced, identified, logged and measured, including those rejected
+	// by the middlewares
+	r.Use(middleware.Tracing(), middleware.RequestID(), middleware.AccessLog(), middleware.Recovery(), middleware.Metrics(recorder))
+
+	// CORS configuration, cross-origin requests are allowed from the configured origins
+	corsConfig := cors.DefaultConfig()
+	if cfg.AllowAllOrigins() {
+		corsConfig.AllowAllOrigins = true
+	} else {
+		corsConfig.AllowOrigins = cfg.Server.CORSOrigins
+	}
+	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
+	corsConfig.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key", "X-Request-ID", "Last-Event-ID", "traceparent", "tracestate", "If-None-Match", "If-Modified-Since"}
+	corsConfig.ExposeHeaders = []string{"ETag", "X-Request-ID", "WWW-Authenticate", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy", "Retry-After"}
+	r.Use(cors.New(corsConfig))
+
+	// Responses are validated against the OpenAPI spec when debugging
+	if cfg.Docs.ValidateResponses {
+		r.Use(middleware.ValidateResponses(spec))
+	}
+
+	// Request parameters are validated against the OpenAPI spec once the request is charged
+	// to the rate limit, so that invalid requests are not free
+	validate := middleware.ValidateRequests(spec)
+
+	// Probe routes, served without authentication nor rate limit
+	r.GET("/livez", middleware.NoStore(), healthHandler.Live)
+	r.GET("/readyz", middleware.NoStore(), healthHandler.Ready)
 
 	// Documentation routes
 	r.GET("/docs", docsHandler.ServeDocsUI)
@@ -79,11 +432,33 @@ func setupRouter(validatorHandler *handlers.ValidatorHandler, eventHandler *hand
 	r.GET("/docs/css", docsHandler.ServeDocsCSS)
 	r.GET("/docs/js", docsHandler.ServeDocsJS)
 
-	// API routes
-	api := r.Group("/api/v1")
+	// Metrics route
+	r.GET("/metrics", auth.Authenticate(), rateLimit.Handler(), validate, middleware.RequireScope(entities.ScopeRead), middleware.NoStore(), gin.WrapH(recorder.Handler()))
+
+	// GraphQL routes
+	graphqlRoutes := r.Group("/graphql", auth.Authenticate(), rateLimit.Handler(), validate, middleware.RequireScope(entities.ScopeRead))
+	{
+		graphqlRoutes.GET("", graphqlHandler.Serve)
+		graphqlRoutes.POST("", graphqlHandler.Serve)
+	}
+
+	// Stream routes, which also read the API key from the api_key query parameter for the
+	// browser clients that cannot set headers
+	stream := r.Group("/api/v1/stream", auth.AuthenticateStream(), rateLimit.Handler(), validate, middleware.RequireScope(entities.ScopeRead))
 	{
+		stream.GET("/events", streamHandler.StreamEvents)
+		stream.GET("/events/ws", streamHandler.StreamEventsWebSocket)
+	}
+
+	// API routes, the principal of every request is attached to its context before the
+	// request is charged to the rate limit of its client
+	api := r.Group("/api/v1", auth.Authenticate(), rateLimit.Handler(), validate)
+	{
+		// Data routes require the read scope
+		read := api.Group("", middleware.RequireScope(entities.ScopeRead))
+
 		// Validator routes
-		validators := api.Group("/validators")
+		validators := read.Group("/validators", middleware.Compress(), cache.Handler(revalidateCacheControl))
 		{
 			validators.GET("", validatorHandler.GetAllValidators)
 			validators.GET("/:type", validatorHandler.GetValidatorByType)
@@ -91,10 +466,13 @@ func setupRouter(validatorHandler *handlers.ValidatorHandler, eventHandler *hand
 			validators.GET("/:type/events/:eventType", validatorHandler.GetValidatorEventsByType)
 			validators.GET("/:type/events/blocks/:start/:end", validatorHandler.GetValidatorEventsByBlockRange)
 			validators.GET("/:type/stats", validatorHandler.GetValidatorStats)
+			validators.GET("/:type/payouts", validatorHandler.GetValidatorPayouts)
+			validators.GET("/:type/stake", validatorHandler.GetValidatorStake)
+			validators.GET("/:type/incidents", incidentHandler.GetValidatorIncidents)
 		}
 
 		// Event routes
-		events := api.Group("/events")
+		events := read.Group("/events", middleware.Compress(), cache.Handler(revalidateCacheControl))
 		{
 			events.GET("", eventHandler.GetAllEvents)
 			events.GET("/:eventType", eventHandler.GetEventsByType)
@@ -104,17 +482,69 @@ func setupRouter(validatorHandler *handlers.ValidatorHandler, eventHandler *hand
 			events.GET("/stats", eventHandler.GetEventStats)
 		}
 
+		// Incident routes
+		read.GET("/incidents", middleware.Compress(), cache.Handler(analyticsCacheControl), incidentHandler.GetIncidents)
+
+		// Offence routes
+		read.GET("/offences", middleware.Compress(), cache.Handler(analyticsCacheControl), offenceHandler.GetOffences)
+
+		// Extrinsic routes
+		read.GET("/extrinsics/failures", middleware.Compress(), cache.Handler(analyticsCacheControl), extrinsicHandler.GetExtrinsicFailures)
+
+		// Consensus routes
+		read.GET("/epochs", middleware.Compress(), cache.Handler(analyticsCacheControl), epochHandler.GetEpochs)
+
+		// Webhook routes
+		webhooks := api.Group("/webhooks", middleware.RequireScope(entities.ScopeAdmin), middleware.NoStore())
+		{
+			webhooks.POST("", webhookHandler.CreateWebhook)
+			webhooks.GET("", webhookHandler.GetWebhooks)
+			webhooks.GET("/:id", webhookHandler.GetWebhook)
+			webhooks.PUT("/:id", webhookHandler.UpdateWebhook)
+			webhooks.DELETE("/:id", webhookHandler.DeleteWebhook)
+			webhooks.GET("/:id/deliveries", webhookHandler.GetWebhookDeliveries)
+			webhooks.POST("/:id/ping", webhookHandler.PingWebhook)
+		}
+
+		// Alert routes
+		alerts := read.Group("/alerts", middleware.NoStore())
+		{
+			alerts.GET("", alertHandler.GetAlerts)
+			alerts.GET("/:id", alertHandler.GetAlert)
+			alerts.GET("/rules", alertHandler.GetAlertRules)
+			alerts.POST("/rules", middleware.RequireScope(entities.ScopeAdmin), alertHandler.CreateAlertRule)
+			alerts.GET("/rules/:id", alertHandler.GetAlertRule)
+			alerts.PUT("/rules/:id", middleware.RequireScope(entities.ScopeAdmin), alertHandler.UpdateAlertRule)
+			alerts.DELETE("/rules/:id", middleware.RequireScope(entities.ScopeAdmin), alertHandler.DeleteAlertRule)
+		}
+
+		// Export routes
+		export := read.Group("/export")
+		{
+			export.GET("/events", exportHandler.ExportEvents)
+			export.GET("/validators", exportHandler.ExportValidators)
+		}
+
+		// Admin routes
+		admin := api.Group("/admin", middleware.RequireScope(entities.ScopeAdmin), middleware.NoStore())
+		{
+			admin.POST("/keys", apiKeyHandler.CreateAPIKey)
+			admin.GET("/keys", apiKeyHandler.GetAPIKeys)
+			admin.GET("/keys/:id", apiKeyHandler.GetAPIKey)
+			admin.PUT("/keys/:id", apiKeyHandler.UpdateAPIKey)
+			admin.DELETE("/keys/:id", apiKeyHandler.DeleteAPIKey)
+			admin.GET("/config", configHandler.GetConfig)
+		}
+
 		// System routes
-		api.GET("/health", healthCheck)
+		api.GET("/health", middleware.NoStore(), healthHandler.Ready)
 	}
 
 	return r
 }
 
-func healthCheck(c *gin.Context) {
-	response.Success(c, map[string]interface{}{
-		"status":  "healthy",
-		"service": "blockchain-data-api",
-		"version": "1.0.0",
-	})
+// fatal logs an error preventing the server from running and exits
+func fatal(message string, err error) {
+	slog.Error(message, "error", err)
+	os.Exit(1)
 }
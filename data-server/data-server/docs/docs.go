@@ -0,0 +1,10 @@
+// Package docs embeds the API documentation, so that the server serves and enforces the
+// specification it was built with wherever it runs
+package docs
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI specification of the HTTP API
+//
+//go:embed openapi.yaml
+var OpenAPI []byte
@@ -0,0 +1,122 @@
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"data-server/internal/ports/input"
+
+	"github.com/gin-gonic/gin"
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Request represents a GraphQL request sent as JSON body or query parameters
+type Request struct {
+	Query         string                 `json:"query" form:"query"`
+	OperationName string                 `json:"operationName" form:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Charger takes the cost of a query from the rate limit of its client, on top of the
+// cost of the request, rejecting the request and returning false if too few tokens are
+// left
+type Charger interface {
+	Charge(c *gin.Context, cost int) bool
+}
+
+// Handler handles GraphQL HTTP requests
+type Handler struct {
+	schema  gql.Schema
+	charger Charger
+}
+
+// NewHandler creates a new GraphQL handler over the validator and event services,
+// charging queries one token per ComplexityPerToken of complexity
+func NewHandler(validatorService input.ValidatorService, eventService input.EventService, charger Charger) (*Handler, error) {
+	schema, err := NewSchema(validatorService, eventService)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{
+		schema:  schema,
+		charger: charger,
+	}, nil
+}
+
+// Serve handles GET and POST /graphql. Requests that cannot be parsed, fail validation
+// or exceed the depth and complexity limits are rejected with 400 before execution, and
+// requests whose client cannot afford the complexity of the query with 429
+func (h *Handler) Serve(c *gin.Context) {
+	request, ok := h.bindRequest(c)
+	if !ok {
+		return
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(request.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		respondErrors(c, http.StatusBadRequest, gqlerrors.FormatError(err))
+		return
+	}
+
+	if validation := gql.ValidateDocument(&h.schema, doc, nil); !validation.IsValid {
+		respondErrors(c, http.StatusBadRequest, validation.Errors...)
+		return
+	}
+
+	complexity, err := checkLimits(doc, request.OperationName, request.Variables)
+	if err != nil {
+		respondErrors(c, http.StatusBadRequest, gqlerrors.FormatError(err))
+		return
+	}
+	if !h.charger.Charge(c, complexity/ComplexityPerToken) {
+		return
+	}
+
+	result := gql.Execute(gql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           doc,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       c.Request.Context(),
+	})
+
+	c.JSON(http.StatusOK, result)
+}
+
+// bindRequest reads the GraphQL request from the JSON body of a POST request or the
+// query parameters of a GET request
+func (h *Handler) bindRequest(c *gin.Context) (Request, bool) {
+	var request Request
+
+	if c.Request.Method == http.MethodGet {
+		request.Query = c.Query("query")
+		request.OperationName = c.Query("operationName")
+		if variables := c.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				respondErrors(c, http.StatusBadRequest, gqlerrors.NewFormattedError("variables must be a JSON object"))
+				return request, false
+			}
+		}
+	} else if err := c.ShouldBindJSON(&request); err != nil {
+		respondErrors(c, http.StatusBadRequest, gqlerrors.NewFormattedError("request body must be a JSON object with a query"))
+		return request, false
+	}
+
+	if request.Query == "" {
+		respondErrors(c, http.StatusBadRequest, gqlerrors.NewFormattedError("query is required"))
+		return request, false
+	}
+
+	return request, true
+}
+
+// respondErrors sends a GraphQL response holding only errors
+func respondErrors(c *gin.Context, status int, errs ...gqlerrors.FormattedError) {
+	c.JSON(status, gql.Result{Errors: errs})
+}
@@ -0,0 +1,180 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"data-server/internal/domain/valueobjects"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const (
+	// MaxQueryDepth is the maximum nesting depth of the fields selected by a query
+	MaxQueryDepth = 8
+
+	// MaxIntrospectionDepth is the maximum nesting depth of introspection fields, deep
+	// enough for the type references of the introspection query of GraphQL clients
+	MaxIntrospectionDepth = 16
+
+	// MaxQueryComplexity is the maximum estimated number of fields resolved by a query
+	MaxQueryComplexity = 5000
+
+	// ComplexityPerToken is the complexity of a query costing one more token of the rate
+	// limit of its client
+	ComplexityPerToken = 100
+)
+
+// paginatedFields are the fields returning a page of items with the most items such a
+// page can hold, their selections are resolved once per item of the page. Validators are
+// stored by type, so there are never more than three of them
+var paginatedFields = map[string]int{
+	"validators": 3,
+	"events":     valueobjects.MaxPageLimit,
+}
+
+// LimitError is returned when a query exceeds the depth or complexity limits
+type LimitError struct {
+	Limit  string
+	Actual int
+	Max    int
+}
+
+// Error implements the error interface
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("query %s %d exceeds the maximum of %d", e.Limit, e.Actual, e.Max)
+}
+
+// selectionCost represents the complexity and the nesting depths of a selection set
+type selectionCost struct {
+	complexity         int
+	depth              int
+	introspectionDepth int
+}
+
+// queryCost walks the selections of an operation to compute its depth and complexity
+type queryCost struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+// checkLimits returns the complexity of the executed operation of the document, or a
+// LimitError if it is nested deeper than MaxQueryDepth or its complexity exceeds
+// MaxQueryComplexity. Every field costs one, selections of paginated fields are
+// multiplied by the requested page size. Introspection fields cost nothing and are
+// limited to MaxIntrospectionDepth instead
+func checkLimits(doc *ast.Document, operationName string, variables map[string]interface{}) (int, error) {
+	cost := &queryCost{
+		fragments: map[string]*ast.FragmentDefinition{},
+		variables: variables,
+	}
+
+	var operations []*ast.OperationDefinition
+	for _, definition := range doc.Definitions {
+		switch d := definition.(type) {
+		case *ast.OperationDefinition:
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				operations = append(operations, d)
+			}
+		case *ast.FragmentDefinition:
+			cost.fragments[d.Name.Value] = d
+		}
+	}
+
+	highest := 0
+	for _, operation := range operations {
+		selection := cost.selectionSet(operation.SelectionSet, 0, false, map[string]bool{})
+		if selection.depth > MaxQueryDepth {
+			return 0, &LimitError{Limit: "depth", Actual: selection.depth, Max: MaxQueryDepth}
+		}
+		if selection.introspectionDepth > MaxIntrospectionDepth {
+			return 0, &LimitError{Limit: "introspection depth", Actual: selection.introspectionDepth, Max: MaxIntrospectionDepth}
+		}
+		if selection.complexity > MaxQueryComplexity {
+			return 0, &LimitError{Limit: "complexity", Actual: selection.complexity, Max: MaxQueryComplexity}
+		}
+		if selection.complexity > highest {
+			highest = selection.complexity
+		}
+	}
+
+	return highest, nil
+}
+
+// selectionSet returns the complexity and the depths of a selection set at the given
+// depth, expanding fragments that are not already being expanded. Fields within an
+// introspection field count towards the introspection depth only
+func (q *queryCost) selectionSet(set *ast.SelectionSet, depth int, introspection bool, expanding map[string]bool) selectionCost {
+	total := selectionCost{}
+	if set == nil {
+		return total
+	}
+
+	for _, selection := range set.Selections {
+		var nested selectionCost
+		switch s := selection.(type) {
+		case *ast.Field:
+			within := introspection || strings.HasPrefix(s.Name.Value, "__")
+			nested = q.selectionSet(s.SelectionSet, depth+1, within, expanding)
+			if within {
+				nested.introspectionDepth = max(nested.introspectionDepth, depth+1)
+			} else {
+				nested.depth = max(nested.depth, depth+1)
+				nested.complexity = 1 + q.multiplier(s)*nested.complexity
+			}
+		case *ast.InlineFragment:
+			nested = q.selectionSet(s.SelectionSet, depth, introspection, expanding)
+		case *ast.FragmentSpread:
+			fragment, ok := q.fragments[s.Name.Value]
+			if !ok || expanding[s.Name.Value] {
+				continue
+			}
+			expanding[s.Name.Value] = true
+			nested = q.selectionSet(fragment.SelectionSet, depth, introspection, expanding)
+			delete(expanding, s.Name.Value)
+		}
+
+		total.complexity += nested.complexity
+		total.depth = max(total.depth, nested.depth)
+		total.introspectionDepth = max(total.introspectionDepth, nested.introspectionDepth)
+	}
+
+	return total
+}
+
+// multiplier returns the number of times the selections of a field are resolved: the
+// requested page size, or the default one, bounded by the most items a page can hold
+func (q *queryCost) multiplier(field *ast.Field) int {
+	most, paginated := paginatedFields[field.Name.Value]
+	if !paginated {
+		return 1
+	}
+
+	first := valueobjects.DefaultPageLimit
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "first" {
+			continue
+		}
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(value.Value); err == nil && n > 0 {
+				first = n
+			}
+		case *ast.Variable:
+			switch n := q.variables[value.Name.Value].(type) {
+			case int:
+				if n > 0 {
+					first = n
+				}
+			case float64:
+				// Bounded before the conversion, so that huge values cannot overflow
+				if n > 0 {
+					first = int(min(n, float64(most)))
+				}
+			}
+		}
+	}
+
+	return min(first, most)
+}
@@ -0,0 +1,125 @@
+package graphql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestCheckLimits(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		variables  map[string]interface{}
+		complexity int
+		limit      string
+	}{
+		{
+			name:       "every field costs one",
+			query:      `{ validator(type: "good") { stash stats { totalEvents } } }`,
+			complexity: 4,
+		},
+		{
+			name:       "validators are bounded by the validator types, events by the default page",
+			query:      `{ validators { items { events { items { block } } } } }`,
+			complexity: 1 + 3*(1+1+100*(1+1)),
+		},
+		{
+			name:       "fragments are expanded",
+			query:      `{ events(first: 10) { ...items } } fragment items on EventConnection { items { block index } }`,
+			complexity: 1 + 10*(1+2),
+		},
+		{
+			name:       "a page larger than the maximum is charged as the maximum",
+			query:      `{ events(first: 2000000000) { items { block } } }`,
+			complexity: 1 + 1000*(1+1),
+		},
+		{
+			name:       "a huge page size variable is bounded before the conversion",
+			query:      `query ($first: Int) { events(first: $first) { items { block } } }`,
+			variables:  map[string]interface{}{"first": 1e300},
+			complexity: 1 + 1000*(1+1),
+		},
+		{
+			name:  "too complex",
+			query: `{ events(first: 1000) { items { block index event data } } }`,
+			limit: "complexity",
+		},
+		{
+			name:  "too deep",
+			query: `{ a { b { c { d { e { f { g { h { i } } } } } } } } }`,
+			limit: "depth",
+		},
+		{
+			name:       "introspection is free",
+			query:      testutil.IntrospectionQuery,
+			complexity: 0,
+		},
+		{
+			name:       "typenames are free",
+			query:      `{ validators { __typename items { __typename stash } } }`,
+			complexity: 1 + 3*(1+1),
+		},
+		{
+			name:  "introspection is limited in depth",
+			query: `{ __schema { types { fields { type { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { name } } } } } } } } } } } } } } } } }`,
+			limit: "introspection depth",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.Parse(parser.ParseParams{Source: tt.query})
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+
+			complexity, err := checkLimits(doc, "", tt.variables)
+
+			var limitErr *LimitError
+			switch {
+			case tt.limit != "" && (!errors.As(err, &limitErr) || limitErr.Limit != tt.limit):
+				t.Errorf("checkLimits error = %v, want a %s LimitError", err, tt.limit)
+			case tt.limit == "" && err != nil:
+				t.Errorf("checkLimits: %v", err)
+			case tt.limit == "" && complexity != tt.complexity:
+				t.Errorf("complexity = %d, want %d", complexity, tt.complexity)
+			}
+		})
+	}
+}
+
+func TestScalarLiterals(t *testing.T) {
+	if got := Long.ParseLiteral(&ast.IntValue{Value: "9007199254740993"}); got != int64(9007199254740993) {
+		t.Errorf("Long literal = %#v, want the 64 bit integer", got)
+	}
+	for _, invalid := range []ast.Value{
+		&ast.IntValue{Value: "99999999999999999999"},
+		&ast.StringValue{Value: "1"},
+		&ast.FloatValue{Value: "1.5"},
+	} {
+		if got := Long.ParseLiteral(invalid); got != nil {
+			t.Errorf("Long literal %#v = %#v, want nil to fail validation", invalid, got)
+		}
+	}
+
+	literal := &ast.ObjectValue{Fields: []*ast.ObjectField{
+		{Name: &ast.Name{Value: "amount"}, Value: &ast.IntValue{Value: "15000000000"}},
+		{Name: &ast.Name{Value: "share"}, Value: &ast.FloatValue{Value: "0.25"}},
+		{Name: &ast.Name{Value: "tags"}, Value: &ast.ListValue{Values: []ast.Value{
+			&ast.StringValue{Value: "payout"},
+			&ast.BooleanValue{Value: true},
+		}}},
+	}}
+	got, ok := JSON.ParseLiteral(literal).(map[string]interface{})
+	if !ok {
+		t.Fatalf("JSON literal = %#v, want an object", JSON.ParseLiteral(literal))
+	}
+	tags, _ := got["tags"].([]interface{})
+	if got["amount"] != int64(15000000000) || got["share"] != 0.25 || len(tags) != 2 || tags[0] != "payout" || tags[1] != true {
+		t.Errorf("JSON literal = %#v", got)
+	}
+}
@@ -0,0 +1,480 @@
+package graphql
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/expression"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// resolver resolves GraphQL fields through the validator and event services
+type resolver struct {
+	validatorService input.ValidatorService
+	eventService     input.EventService
+}
+
+// NewSchema builds the GraphQL schema over the validator and event services
+func NewSchema(validatorService input.ValidatorService, eventService input.EventService) (gql.Schema, error) {
+	r := &resolver{
+		validatorService: validatorService,
+		eventService:     eventService,
+	}
+
+	validatorType := r.validatorType()
+
+	query := gql.NewObject(gql.ObjectConfig{
+		Name: "Query",
+		Fields: gql.Fields{
+			"validators": &gql.Field{
+				Type:        gql.NewNonNull(connectionType[*entities.Validator]("ValidatorConnection", validatorType)),
+				Description: "All validators",
+				Args:        pageArgs(gql.FieldConfigArgument{}),
+				Resolve:     r.validators,
+			},
+			"validator": &gql.Field{
+				Type:        validatorType,
+				Description: "A validator by its type (good, neutral, bad)",
+				Args: gql.FieldConfigArgument{
+					"type": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+				},
+				Resolve: r.validator,
+			},
+			"events": r.eventsField("Events of all validators matching the filters", true, r.events),
+			"eventStats": &gql.Field{
+				Type:        gql.NewNonNull(eventStatsType),
+				Description: "Statistics about all events",
+				Resolve:     r.eventStats,
+			},
+		},
+	})
+
+	return gql.NewSchema(gql.SchemaConfig{Query: query})
+}
+
+// validators resolves the validators query
+func (r *resolver) validators(p gql.ResolveParams) (interface{}, error) {
+	page, err := pageRequest(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	return r.validatorService.GetAllValidators(p.Context, page)
+}
+
+// validator resolves the validator query
+func (r *resolver) validator(p gql.ResolveParams) (interface{}, error) {
+	validatorType, _ := p.Args["type"].(string)
+	return r.validatorService.GetValidatorByType(p.Context, validatorType)
+}
+
+// events resolves the events query
+func (r *resolver) events(p gql.ResolveParams) (interface{}, error) {
+	query, page, err := eventQuery(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	return r.eventService.QueryEvents(p.Context, query, page)
+}
+
+// eventStats resolves the eventStats query
+func (r *resolver) eventStats(p gql.ResolveParams) (interface{}, error) {
+	return r.eventService.GetEventStats(p.Context)
+}
+
+// validatorEvents resolves the events of a validator
+func (r *resolver) validatorEvents(p gql.ResolveParams) (interface{}, error) {
+	validator := p.Source.(*entities.Validator)
+	query, page, err := eventQuery(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	return r.validatorService.QueryValidatorEvents(p.Context, string(validator.Type), query, page)
+}
+
+// validatorStats resolves the statistics of a validator
+func (r *resolver) validatorStats(p gql.ResolveParams) (interface{}, error) {
+	validator := p.Source.(*entities.Validator)
+	return r.validatorService.GetValidatorStats(p.Context, string(validator.Type))
+}
+
+// validatorPayouts resolves the payout reconciliation of a validator
+func (r *resolver) validatorPayouts(p gql.ResolveParams) (interface{}, error) {
+	validator := p.Source.(*entities.Validator)
+	return r.validatorService.GetValidatorPayouts(p.Context, string(validator.Type))
+}
+
+// eventsField creates a paginated events field accepting the event query filters,
+// the stash filter is only offered across validators
+func (r *resolver) eventsField(description string, withStash bool, resolve gql.FieldResolveFn) *gql.Field {
+	args := gql.FieldConfigArgument{
+		"type":       &gql.ArgumentConfig{Type: gql.NewList(gql.NewNonNull(gql.String)), Description: "Event types, e.g. staking.Rewarded"},
+		"category":   &gql.ArgumentConfig{Type: gql.NewList(gql.NewNonNull(gql.String)), Description: "Event categories"},
+		"startBlock": &gql.ArgumentConfig{Type: gql.Int, Description: "First block of the range"},
+		"endBlock":   &gql.ArgumentConfig{Type: gql.Int, Description: "Last block of the range"},
+		"from":       &gql.ArgumentConfig{Type: gql.DateTime, Description: "Earliest event timestamp"},
+		"to":         &gql.ArgumentConfig{Type: gql.DateTime, Description: "Latest event timestamp"},
+		"where":      &gql.ArgumentConfig{Type: gql.NewList(gql.NewNonNull(gql.String)), Description: "Payload predicates written as field:operator:value"},
+		"filter":     &gql.ArgumentConfig{Type: gql.String, Description: "CEL filter expression"},
+	}
+	if withStash {
+		args["stash"] = &gql.ArgumentConfig{Type: gql.NewList(gql.NewNonNull(gql.String)), Description: "Validator stash addresses"}
+	}
+
+	return &gql.Field{
+		Type:        gql.NewNonNull(eventConnectionType),
+		Description: description,
+		Args:        pageArgs(args),
+		Resolve:     resolve,
+	}
+}
+
+// pageArgs adds the pagination arguments to the given field arguments
+func pageArgs(args gql.FieldConfigArgument) gql.FieldConfigArgument {
+	args["first"] = &gql.ArgumentConfig{Type: gql.Int, Description: "Maximum number of items to return"}
+	args["after"] = &gql.ArgumentConfig{Type: gql.String, Description: "Cursor returned as nextCursor by the previous page"}
+	args["order"] = &gql.ArgumentConfig{Type: sortDirectionEnum, Description: "Sort direction"}
+	return args
+}
+
+// pageRequest converts the pagination arguments into a page request
+func pageRequest(args map[string]interface{}) (valueobjects.PageRequest, error) {
+	first, _ := args["first"].(int)
+	after, _ := args["after"].(string)
+	order, _ := args["order"].(string)
+	return valueobjects.NewPageRequest(first, after, valueobjects.SortDirection(order))
+}
+
+// eventQuery converts the event filter and pagination arguments into an event query
+// and page request
+func eventQuery(args map[string]interface{}) (entities.EventQuery, valueobjects.PageRequest, error) {
+	query := entities.EventQuery{
+		Types:      stringList(args["type"]),
+		Categories: stringList(args["category"]),
+		Stashes:    stringList(args["stash"]),
+	}
+
+	startBlock, hasStart := args["startBlock"].(int)
+	endBlock, hasEnd := args["endBlock"].(int)
+	if hasStart || hasEnd {
+		if !hasEnd {
+			endBlock = math.MaxInt
+		}
+		blockRange, err := valueobjects.NewBlockRange(startBlock, endBlock)
+		if err != nil {
+			return query, valueobjects.PageRequest{}, err
+		}
+		query.BlockRange = blockRange
+	}
+
+	if from, ok := args["from"].(time.Time); ok {
+		query.From = &from
+	}
+	if to, ok := args["to"].(time.Time); ok {
+		query.To = &to
+	}
+
+	for _, where := range stringList(args["where"]) {
+		predicate, err := entities.ParsePayloadPredicate(where)
+		if err != nil {
+			return query, valueobjects.PageRequest{}, err
+		}
+		query.Predicates = append(query.Predicates, predicate)
+	}
+
+	if source, ok := args["filter"].(string); ok {
+		filter, err := expression.Compile(source)
+		if err != nil {
+			return query, valueobjects.PageRequest{}, err
+		}
+		query.Filter = filter
+	}
+
+	if err := query.Validate(); err != nil {
+		return query, valueobjects.PageRequest{}, err
+	}
+
+	page, err := pageRequest(args)
+	return query, page, err
+}
+
+// stringList converts a list argument into a slice of strings
+func stringList(value interface{}) []string {
+	items, _ := value.([]interface{})
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// field creates a field resolving to the value returned by get for a source of type T
+func field[T any](typ gql.Output, get func(T) interface{}) *gql.Field {
+	return &gql.Field{
+		Type: typ,
+		Resolve: func(p gql.ResolveParams) (interface{}, error) {
+			source, ok := p.Source.(T)
+			if !ok {
+				return nil, errors.New("unexpected source type")
+			}
+			return get(source), nil
+		},
+	}
+}
+
+// Long is a 64 bit integer scalar used for balances, which overflow the GraphQL Int
+var Long = gql.NewScalar(gql.ScalarConfig{
+	Name:        "Long",
+	Description: "64 bit integer, used for balances",
+	Serialize: func(value interface{}) interface{} {
+		return value
+	},
+	ParseValue: func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case int:
+			return int64(v)
+		case float64:
+			return int64(v)
+		}
+		return nil
+	},
+	ParseLiteral: func(value ast.Value) interface{} {
+		// Anything but an integer literal within 64 bits fails validation as nil
+		if v, ok := value.(*ast.IntValue); ok {
+			if n, err := strconv.ParseInt(v.Value, 10, 64); err == nil {
+				return n
+			}
+		}
+		return nil
+	},
+})
+
+// JSON is a scalar holding arbitrary JSON, used for event payloads and counter maps
+var JSON = gql.NewScalar(gql.ScalarConfig{
+	Name:        "JSON",
+	Description: "Arbitrary JSON value",
+	Serialize: func(value interface{}) interface{} {
+		return value
+	},
+	ParseValue: func(value interface{}) interface{} {
+		return value
+	},
+	ParseLiteral: jsonLiteral,
+})
+
+// jsonLiteral returns the JSON value written as a GraphQL literal, or nil for the
+// literals that have none such as variables
+func jsonLiteral(value ast.Value) interface{} {
+	switch v := value.(type) {
+	case *ast.StringValue:
+		return v.Value
+	case *ast.EnumValue:
+		return v.Value
+	case *ast.BooleanValue:
+		return v.Value
+	case *ast.IntValue:
+		if n, err := strconv.ParseInt(v.Value, 10, 64); err == nil {
+			return n
+		}
+		if f, err := strconv.ParseFloat(v.Value, 64); err == nil {
+			return f
+		}
+	case *ast.FloatValue:
+		if f, err := strconv.ParseFloat(v.Value, 64); err == nil {
+			return f
+		}
+	case *ast.ListValue:
+		items := make([]interface{}, 0, len(v.Values))
+		for _, item := range v.Values {
+			items = append(items, jsonLiteral(item))
+		}
+		return items
+	case *ast.ObjectValue:
+		fields := make(map[string]interface{}, len(v.Fields))
+		for _, field := range v.Fields {
+			fields[field.Name.Value] = jsonLiteral(field.Value)
+		}
+		return fields
+	}
+	return nil
+}
+
+var sortDirectionEnum = gql.NewEnum(gql.EnumConfig{
+	Name: "SortDirection",
+	Values: gql.EnumValueConfigMap{
+		"ASC":  &gql.EnumValueConfig{Value: string(valueobjects.SortAscending)},
+		"DESC": &gql.EnumValueConfig{Value: string(valueobjects.SortDescending)},
+	},
+})
+
+var pageInfoType = gql.NewObject(gql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: gql.Fields{
+		"limit":      field(gql.NewNonNull(gql.Int), func(i valueobjects.PageInfo) interface{} { return i.Limit }),
+		"direction":  field(gql.NewNonNull(sortDirectionEnum), func(i valueobjects.PageInfo) interface{} { return string(i.Direction) }),
+		"count":      field(gql.NewNonNull(gql.Int), func(i valueobjects.PageInfo) interface{} { return i.Count }),
+		"total":      field(gql.NewNonNull(gql.Int), func(i valueobjects.PageInfo) interface{} { return i.Total }),
+		"hasMore":    field(gql.NewNonNull(gql.Boolean), func(i valueobjects.PageInfo) interface{} { return i.HasMore }),
+		"nextCursor": field(gql.String, func(i valueobjects.PageInfo) interface{} { return nullable(i.NextCursor) }),
+	},
+})
+
+var eventType = gql.NewObject(gql.ObjectConfig{
+	Name: "Event",
+	Fields: gql.Fields{
+		"block":     field(gql.NewNonNull(gql.Int), func(e entities.Event) interface{} { return e.Block }),
+		"index":     field(gql.NewNonNull(gql.Int), func(e entities.Event) interface{} { return e.Index }),
+		"event":     field(gql.NewNonNull(gql.String), func(e entities.Event) interface{} { return e.Event }),
+		"category":  field(gql.NewNonNull(gql.String), func(e entities.Event) interface{} { return e.GetEventCategory() }),
+		"timestamp": field(gql.NewNonNull(gql.DateTime), func(e entities.Event) interface{} { return e.Timestamp }),
+		"data":      field(JSON, func(e entities.Event) interface{} { return e.Data }),
+	},
+})
+
+var eventConnectionType = connectionType[entities.Event]("EventConnection", eventType)
+
+// connectionType creates the type of a page of items with its pagination metadata
+func connectionType[T any](name string, item gql.Output) *gql.Object {
+	return gql.NewObject(gql.ObjectConfig{
+		Name: name,
+		Fields: gql.Fields{
+			"items":    field(gql.NewNonNull(gql.NewList(gql.NewNonNull(item))), func(p *input.Page[T]) interface{} { return p.Items }),
+			"pageInfo": field(gql.NewNonNull(pageInfoType), func(p *input.Page[T]) interface{} { return p.Info }),
+		},
+	})
+}
+
+// validatorType creates the validator type, whose nested events, stats and payouts
+// are resolved through the services
+func (r *resolver) validatorType() *gql.Object {
+	return gql.NewObject(gql.ObjectConfig{
+		Name: "Validator",
+		Fields: gql.Fields{
+			"type":        field(gql.NewNonNull(gql.String), func(v *entities.Validator) interface{} { return string(v.Type) }),
+			"stash":       field(gql.NewNonNull(gql.String), func(v *entities.Validator) interface{} { return v.Stash }),
+			"description": field(gql.NewNonNull(gql.String), func(v *entities.Validator) interface{} { return v.Description }),
+			"eventCount":  field(gql.NewNonNull(gql.Int), func(v *entities.Validator) interface{} { return len(v.Events) }),
+			"createdAt":   field(gql.NewNonNull(gql.DateTime), func(v *entities.Validator) interface{} { return v.CreatedAt }),
+			"updatedAt":   field(gql.NewNonNull(gql.DateTime), func(v *entities.Validator) interface{} { return v.UpdatedAt }),
+			"events":      r.eventsField("Events of the validator matching the filters", false, r.validatorEvents),
+			"stats": &gql.Field{
+				Type:        gql.NewNonNull(validatorStatsType),
+				Description: "Statistics of the validator",
+				Resolve:     r.validatorStats,
+			},
+			"payouts": &gql.Field{
+				Type:        gql.NewNonNull(payoutReportType),
+				Description: "Era payout reconciliation of the validator",
+				Resolve:     r.validatorPayouts,
+			},
+		},
+	})
+}
+
+var payoutSummaryType = gql.NewObject(gql.ObjectConfig{
+	Name: "PayoutSummary",
+	Fields: gql.Fields{
+		"erasTracked":     field(gql.NewNonNull(gql.Int), func(s entities.PayoutSummary) interface{} { return s.ErasTracked }),
+		"rewardedEras":    field(gql.NewNonNull(gql.Int), func(s entities.PayoutSummary) interface{} { return s.RewardedEras }),
+		"unrewardedEras":  field(gql.NewNonNull(gql.Int), func(s entities.PayoutSummary) interface{} { return s.UnrewardedEras }),
+		"missingPayouts":  field(gql.NewNonNull(gql.Int), func(s entities.PayoutSummary) interface{} { return s.MissingPayouts }),
+		"latePayouts":     field(gql.NewNonNull(gql.Int), func(s entities.PayoutSummary) interface{} { return s.LatePayouts }),
+		"skippedEras":     field(gql.NewNonNull(gql.Int), func(s entities.PayoutSummary) interface{} { return s.SkippedEras }),
+		"totalPaidAmount": field(gql.NewNonNull(Long), func(s entities.PayoutSummary) interface{} { return s.TotalPaidAmount }),
+	},
+})
+
+var eraPayoutType = gql.NewObject(gql.ObjectConfig{
+	Name: "EraPayout",
+	Fields: gql.Fields{
+		"era":                field(gql.NewNonNull(gql.Int), func(e entities.EraPayout) interface{} { return e.Era }),
+		"status":             field(gql.NewNonNull(gql.String), func(e entities.EraPayout) interface{} { return string(e.Status) }),
+		"elected":            field(gql.NewNonNull(gql.Boolean), func(e entities.EraPayout) interface{} { return e.Elected }),
+		"eraPaidBlock":       field(gql.Int, func(e entities.EraPayout) interface{} { return nullable(e.EraPaidBlock) }),
+		"payoutStartedBlock": field(gql.Int, func(e entities.EraPayout) interface{} { return nullable(e.PayoutStartedBlock) }),
+		"rewardedBlock":      field(gql.Int, func(e entities.EraPayout) interface{} { return nullable(e.RewardedBlock) }),
+		"amount":             field(gql.NewNonNull(Long), func(e entities.EraPayout) interface{} { return e.Amount }),
+		"delayBlocks":        field(gql.NewNonNull(gql.Int), func(e entities.EraPayout) interface{} { return e.DelayBlocks }),
+		"late":               field(gql.NewNonNull(gql.Boolean), func(e entities.EraPayout) interface{} { return e.Late }),
+	},
+})
+
+var payoutIssueType = gql.NewObject(gql.ObjectConfig{
+	Name: "PayoutIssue",
+	Fields: gql.Fields{
+		"era":         field(gql.NewNonNull(gql.Int), func(i entities.PayoutIssue) interface{} { return i.Era }),
+		"kind":        field(gql.NewNonNull(gql.String), func(i entities.PayoutIssue) interface{} { return string(i.Kind) }),
+		"block":       field(gql.Int, func(i entities.PayoutIssue) interface{} { return nullable(i.Block) }),
+		"description": field(gql.NewNonNull(gql.String), func(i entities.PayoutIssue) interface{} { return i.Description }),
+	},
+})
+
+var payoutReportType = gql.NewObject(gql.ObjectConfig{
+	Name: "PayoutReport",
+	Fields: gql.Fields{
+		"lateThreshold": field(gql.NewNonNull(gql.Int), func(r *entities.PayoutReport) interface{} { return r.LateThreshold }),
+		"eras":          field(gql.NewNonNull(gql.NewList(gql.NewNonNull(eraPayoutType))), func(r *entities.PayoutReport) interface{} { return r.Eras }),
+		"issues":        field(gql.NewNonNull(gql.NewList(gql.NewNonNull(payoutIssueType))), func(r *entities.PayoutReport) interface{} { return r.Issues }),
+		"summary":       field(gql.NewNonNull(payoutSummaryType), func(r *entities.PayoutReport) interface{} { return r.Summary }),
+	},
+})
+
+var consensusParticipationType = gql.NewObject(gql.ObjectConfig{
+	Name: "ConsensusParticipation",
+	Fields: gql.Fields{
+		"epochsInAuthoritySet": field(gql.NewNonNull(gql.Int), func(c entities.ConsensusParticipation) interface{} { return c.EpochsInAuthoritySet }),
+		"epochsFinalized":      field(gql.NewNonNull(gql.Int), func(c entities.ConsensusParticipation) interface{} { return c.EpochsFinalized }),
+		"firstEpoch":           field(gql.Int, func(c entities.ConsensusParticipation) interface{} { return c.FirstEpoch }),
+		"lastEpoch":            field(gql.Int, func(c entities.ConsensusParticipation) interface{} { return c.LastEpoch }),
+		"authoritySetChanges":  field(gql.NewNonNull(gql.Int), func(c entities.ConsensusParticipation) interface{} { return c.AuthoritySetChanges }),
+		"blocksAuthored":       field(gql.NewNonNull(gql.Int), func(c entities.ConsensusParticipation) interface{} { return c.BlocksAuthored }),
+	},
+})
+
+var validatorStatsType = gql.NewObject(gql.ObjectConfig{
+	Name: "ValidatorStats",
+	Fields: gql.Fields{
+		"totalEvents":      field(gql.NewNonNull(gql.Int), func(s *input.ValidatorStats) interface{} { return s.TotalEvents }),
+		"stakingEvents":    field(gql.NewNonNull(gql.Int), func(s *input.ValidatorStats) interface{} { return s.StakingEvents }),
+		"governanceEvents": field(gql.NewNonNull(gql.Int), func(s *input.ValidatorStats) interface{} { return s.GovernanceEvents }),
+		"onlineEvents":     field(gql.NewNonNull(gql.Int), func(s *input.ValidatorStats) interface{} { return s.OnlineEvents }),
+		"offenceEvents":    field(gql.NewNonNull(gql.Int), func(s *input.ValidatorStats) interface{} { return s.OffenceEvents }),
+		"totalRewards":     field(gql.NewNonNull(Long), func(s *input.ValidatorStats) interface{} { return s.TotalRewards }),
+		"isActive":         field(gql.NewNonNull(gql.Boolean), func(s *input.ValidatorStats) interface{} { return s.IsActive }),
+		"hasBeenSlashed":   field(gql.NewNonNull(gql.Boolean), func(s *input.ValidatorStats) interface{} { return s.HasBeenSlashed }),
+		"payouts":          field(gql.NewNonNull(payoutSummaryType), func(s *input.ValidatorStats) interface{} { return s.Payouts }),
+		"stakeState":       field(gql.NewNonNull(gql.String), func(s *input.ValidatorStats) interface{} { return string(s.StakeState) }),
+		"bondedStake":      field(gql.NewNonNull(Long), func(s *input.ValidatorStats) interface{} { return s.BondedStake }),
+		"offencesByKind":   field(gql.NewNonNull(JSON), func(s *input.ValidatorStats) interface{} { return s.OffencesByKind }),
+		"consensus":        field(gql.NewNonNull(consensusParticipationType), func(s *input.ValidatorStats) interface{} { return s.Consensus }),
+	},
+})
+
+var eventStatsType = gql.NewObject(gql.ObjectConfig{
+	Name: "EventStats",
+	Fields: gql.Fields{
+		"totalEvents":      field(gql.NewNonNull(gql.Int), func(s *input.EventStats) interface{} { return s.TotalEvents }),
+		"eventsByType":     field(gql.NewNonNull(JSON), func(s *input.EventStats) interface{} { return s.EventsByType }),
+		"eventsByCategory": field(gql.NewNonNull(JSON), func(s *input.EventStats) interface{} { return s.EventsByCategory }),
+		"eventsByBlock":    field(gql.NewNonNull(JSON), func(s *input.EventStats) interface{} { return s.EventsByBlock }),
+		"totalAmount":      field(gql.NewNonNull(Long), func(s *input.EventStats) interface{} { return s.TotalAmount }),
+		"uniqueValidators": field(gql.NewNonNull(gql.NewList(gql.NewNonNull(gql.String))), func(s *input.EventStats) interface{} { return s.UniqueValidators }),
+	},
+})
+
+// nullable returns nil for zero values so optional fields are resolved as null
+func nullable[T comparable](value T) interface{} {
+	var zero T
+	if value == zero {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Authenticator authenticates gRPC calls with the API key of their authorization or
+// x-api-key metadata, and requires the read scope on every call
+type Authenticator struct {
+	apiKeyService   input.APIKeyService
+	rateLimiter     *RateLimiter
+	anonymousScopes []entities.APIKeyScope
+}
+
+// NewAuthenticator creates a new authenticator, calls without a key are granted the
+// given scopes. Calls failing authentication are charged to the given rate limiter
+func NewAuthenticator(apiKeyService input.APIKeyService, rateLimiter *RateLimiter, anonymousScopes ...entities.APIKeyScope) *Authenticator {
+	return &Authenticator{
+		apiKeyService:   apiKeyService,
+		rateLimiter:     rateLimiter,
+		anonymousScopes: anonymousScopes,
+	}
+}
+
+// ServerOptions returns the options installing the authenticator on a gRPC server
+func (a *Authenticator) ServerOptions() []grpclib.ServerOption {
+	return []grpclib.ServerOption{
+		grpclib.ChainUnaryInterceptor(a.unaryInterceptor),
+		grpclib.ChainStreamInterceptor(a.streamInterceptor),
+	}
+}
+
+// unaryInterceptor authenticates unary calls
+func (a *Authenticator) unaryInterceptor(ctx context.Context, req interface{}, info *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamInterceptor authenticates streaming calls
+func (a *Authenticator) streamInterceptor(srv interface{}, stream grpclib.ServerStream, info *grpclib.StreamServerInfo, handler grpclib.StreamHandler) error {
+	ctx, err := a.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticate returns a copy of the context carrying the principal of the call. Calls
+// with an unknown or expired key are charged to the bucket of their IP address like calls
+// without a key before being rejected, so that guessing keys is rate limited too
+func (a *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	principal := entities.AnonymousPrincipal(a.anonymousScopes...)
+	if secret := metadataCredentials(ctx); secret != "" {
+		authenticated, err := a.apiKeyService.Authenticate(ctx, secret)
+		if err != nil {
+			if limitErr := a.rateLimiter.allow(ctx, a.rateLimiter.costs.Cost(method, 0)); limitErr != nil {
+				return nil, limitErr
+			}
+			return nil, serviceError(ctx, err)
+		}
+		principal = authenticated
+	}
+
+	ctx = entities.WithPrincipal(ctx, principal)
+	if err := entities.RequireScope(ctx, entities.ScopeRead); err != nil {
+		return nil, serviceError(ctx, err)
+	}
+	return ctx, nil
+}
+
+// metadataCredentials returns the API key of a call, or "" if it has none
+func metadataCredentials(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, authorization := range md.Get("authorization") {
+		scheme, token, ok := strings.Cut(authorization, " ")
+		if ok && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	if keys := md.Get("x-api-key"); len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+// contextStream is a server stream with a context of its own, such as a context carrying
+// the principal of the call
+type contextStream struct {
+	grpclib.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
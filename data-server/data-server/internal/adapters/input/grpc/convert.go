@@ -0,0 +1,335 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math"
+
+	blockchainv1 "data-server/api/blockchain/v1"
+	"data-server/internal/domain/domainerr"
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/expression"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// pageRequest converts a protobuf page request into a page request
+func pageRequest(page *blockchainv1.PageRequest) (valueobjects.PageRequest, error) {
+	direction := valueobjects.SortAscending
+	if page.GetOrder() == blockchainv1.SortDirection_SORT_DIRECTION_DESC {
+		direction = valueobjects.SortDescending
+	}
+
+	request, err := valueobjects.NewPageRequest(int(page.GetLimit()), page.GetCursor(), direction)
+	if err != nil {
+		return request, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return request, nil
+}
+
+// eventQuery converts a protobuf event query into an event query
+func eventQuery(query *blockchainv1.EventQuery) (entities.EventQuery, error) {
+	result := entities.EventQuery{
+		Types:      query.GetTypes(),
+		Categories: query.GetCategories(),
+		Stashes:    query.GetStashes(),
+	}
+
+	if query == nil {
+		return result, nil
+	}
+
+	if query.StartBlock != nil || query.EndBlock != nil {
+		startBlock, endBlock := 0, math.MaxInt
+		if query.StartBlock != nil {
+			startBlock = int(query.GetStartBlock())
+		}
+		if query.EndBlock != nil {
+			endBlock = int(query.GetEndBlock())
+		}
+		blockRange, err := valueobjects.NewBlockRange(startBlock, endBlock)
+		if err != nil {
+			return result, status.Error(codes.InvalidArgument, err.Error())
+		}
+		result.BlockRange = blockRange
+	}
+
+	if query.GetFrom() != nil {
+		from := query.GetFrom().AsTime()
+		result.From = &from
+	}
+	if query.GetTo() != nil {
+		to := query.GetTo().AsTime()
+		result.To = &to
+	}
+
+	for _, where := range query.GetWhere() {
+		predicate, err := entities.ParsePayloadPredicate(where)
+		if err != nil {
+			return result, status.Error(codes.InvalidArgument, err.Error())
+		}
+		result.Predicates = append(result.Predicates, predicate)
+	}
+
+	if query.GetFilter() != "" {
+		filter, err := expression.Compile(query.GetFilter())
+		if err != nil {
+			return result, status.Error(codes.InvalidArgument, err.Error())
+		}
+		result.Filter = filter
+	}
+
+	if err := result.Validate(); err != nil {
+		return result, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return result, nil
+}
+
+// statusCodes maps the kinds of domain errors to gRPC status codes
+var statusCodes = map[domainerr.Kind]codes.Code{
+	domainerr.NotFound:        codes.NotFound,
+	domainerr.InvalidInput:    codes.InvalidArgument,
+	domainerr.InvalidRange:    codes.OutOfRange,
+	domainerr.InvalidAddress:  codes.InvalidArgument,
+	domainerr.Unauthenticated: codes.Unauthenticated,
+	domainerr.Forbidden:       codes.PermissionDenied,
+	domainerr.RateLimited:     codes.ResourceExhausted,
+	domainerr.Conflict:        codes.FailedPrecondition,
+	domainerr.Unavailable:     codes.Unavailable,
+}
+
+// serviceError converts an error returned by a service into a gRPC status with the code
+// of its domain error kind, or an internal error for any other error. The cause of an
+// internal error is logged rather than sent to the client
+func serviceError(ctx context.Context, err error) error {
+	code, ok := statusCodes[domainerr.KindOf(err)]
+	if !ok {
+		code = codes.Internal
+	}
+	if code == codes.Internal || code == codes.Unknown {
+		slog.ErrorContext(ctx, "Internal error", "code", code.String(), "error", err)
+		return status.Error(code, "internal error")
+	}
+	return status.Error(code, err.Error())
+}
+
+// toPageInfo converts pagination metadata into its protobuf message
+func toPageInfo(info valueobjects.PageInfo) *blockchainv1.PageInfo {
+	direction := blockchainv1.SortDirection_SORT_DIRECTION_ASC
+	if info.Direction == valueobjects.SortDescending {
+		direction = blockchainv1.SortDirection_SORT_DIRECTION_DESC
+	}
+
+	return &blockchainv1.PageInfo{
+		Limit:      int32(info.Limit),
+		Direction:  direction,
+		Count:      int32(info.Count),
+		Total:      int32(info.Total),
+		HasMore:    info.HasMore,
+		NextCursor: info.NextCursor,
+	}
+}
+
+// toEvent converts an event into its protobuf message
+func toEvent(e entities.Event) (*blockchainv1.Event, error) {
+	event := &blockchainv1.Event{
+		Block:     int64(e.Block),
+		Index:     int32(e.Index),
+		Event:     e.Event,
+		Category:  e.GetEventCategory(),
+		Timestamp: timestamppb.New(e.Timestamp),
+	}
+
+	if e.Data != nil {
+		// Payloads hold typed slices and integers, a JSON round-trip turns them into
+		// values a protobuf struct accepts
+		raw, err := json.Marshal(e.Data)
+		if err != nil {
+			return nil, err
+		}
+		var data map[string]interface{}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, err
+		}
+		if event.Data, err = structpb.NewStruct(data); err != nil {
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+// toEventPage converts a page of events into its protobuf message
+func toEventPage(ctx context.Context, page *input.Page[entities.Event]) (*blockchainv1.EventPage, error) {
+	result := &blockchainv1.EventPage{
+		Events:   make([]*blockchainv1.Event, 0, len(page.Items)),
+		PageInfo: toPageInfo(page.Info),
+	}
+	for _, e := range page.Items {
+		event, err := toEvent(e)
+		if err != nil {
+			return nil, serviceError(ctx, err)
+		}
+		result.Events = append(result.Events, event)
+	}
+	return result, nil
+}
+
+// toValidator converts a validator into its protobuf message
+func toValidator(v *entities.Validator) *blockchainv1.Validator {
+	return &blockchainv1.Validator{
+		Stash:       v.Stash,
+		Type:        string(v.Type),
+		Description: v.Description,
+		EventCount:  int32(len(v.Events)),
+		CreatedAt:   timestamppb.New(v.CreatedAt),
+		UpdatedAt:   timestamppb.New(v.UpdatedAt),
+	}
+}
+
+// toValidatorStats converts validator statistics into their protobuf message
+func toValidatorStats(s *input.ValidatorStats) *blockchainv1.ValidatorStats {
+	stats := &blockchainv1.ValidatorStats{
+		TotalEvents:      int32(s.TotalEvents),
+		StakingEvents:    int32(s.StakingEvents),
+		GovernanceEvents: int32(s.GovernanceEvents),
+		OnlineEvents:     int32(s.OnlineEvents),
+		OffenceEvents:    int32(s.OffenceEvents),
+		TotalRewards:     s.TotalRewards,
+		IsActive:         s.IsActive,
+		HasBeenSlashed:   s.HasBeenSlashed,
+		Payouts:          toPayoutSummary(s.Payouts),
+		StakeState:       string(s.StakeState),
+		BondedStake:      s.BondedStake,
+		OffencesByKind:   toCounts(s.OffencesByKind),
+		Consensus: &blockchainv1.ConsensusParticipation{
+			EpochsInAuthoritySet: int32(s.Consensus.EpochsInAuthoritySet),
+			EpochsFinalized:      int32(s.Consensus.EpochsFinalized),
+			LastEpochBlock:       int64(s.Consensus.LastEpochBlock),
+			AuthoritySetChanges:  int32(s.Consensus.AuthoritySetChanges),
+			BlocksAuthored:       int32(s.Consensus.BlocksAuthored),
+		},
+	}
+	if s.Consensus.FirstEpoch != nil {
+		first := int32(*s.Consensus.FirstEpoch)
+		stats.Consensus.FirstEpoch = &first
+	}
+	if s.Consensus.LastEpoch != nil {
+		last := int32(*s.Consensus.LastEpoch)
+		stats.Consensus.LastEpoch = &last
+	}
+	return stats
+}
+
+// toPayoutSummary converts payout reconciliation counters into their protobuf message
+func toPayoutSummary(s entities.PayoutSummary) *blockchainv1.PayoutSummary {
+	return &blockchainv1.PayoutSummary{
+		ErasTracked:     int32(s.ErasTracked),
+		RewardedEras:    int32(s.RewardedEras),
+		UnrewardedEras:  int32(s.UnrewardedEras),
+		MissingPayouts:  int32(s.MissingPayouts),
+		LatePayouts:     int32(s.LatePayouts),
+		SkippedEras:     int32(s.SkippedEras),
+		TotalPaidAmount: s.TotalPaidAmount,
+	}
+}
+
+// toPayoutReport converts a payout reconciliation into its protobuf message
+func toPayoutReport(r *entities.PayoutReport) *blockchainv1.PayoutReport {
+	report := &blockchainv1.PayoutReport{
+		Stash:               r.Stash,
+		LateThresholdBlocks: int32(r.LateThreshold),
+		Eras:                make([]*blockchainv1.EraPayout, 0, len(r.Eras)),
+		Issues:              make([]*blockchainv1.PayoutIssue, 0, len(r.Issues)),
+		Summary:             toPayoutSummary(r.Summary),
+	}
+	for _, era := range r.Eras {
+		report.Eras = append(report.Eras, &blockchainv1.EraPayout{
+			Era:                int32(era.Era),
+			Status:             string(era.Status),
+			Elected:            era.Elected,
+			EraPaidBlock:       int64(era.EraPaidBlock),
+			PayoutStartedBlock: int64(era.PayoutStartedBlock),
+			RewardedBlock:      int64(era.RewardedBlock),
+			Amount:             era.Amount,
+			DelayBlocks:        int64(era.DelayBlocks),
+			Late:               era.Late,
+		})
+	}
+	for _, issue := range r.Issues {
+		report.Issues = append(report.Issues, &blockchainv1.PayoutIssue{
+			Era:         int32(issue.Era),
+			Kind:        string(issue.Kind),
+			Block:       int64(issue.Block),
+			Description: issue.Description,
+		})
+	}
+	return report
+}
+
+// toStakeLedger converts a stake ledger into its protobuf message
+func toStakeLedger(l *entities.StakeLedger) *blockchainv1.StakeLedger {
+	ledger := &blockchainv1.StakeLedger{
+		Stash:       l.Stash,
+		State:       string(l.State),
+		Bonded:      l.Bonded,
+		Unbonding:   l.Unbonding,
+		Withdrawn:   l.Withdrawn,
+		Slashed:     l.Slashed,
+		Timeline:    make([]*blockchainv1.StakeEntry, 0, len(l.Timeline)),
+		Transitions: make([]*blockchainv1.StakeTransition, 0, len(l.Transitions)),
+	}
+	for _, entry := range l.Timeline {
+		ledger.Timeline = append(ledger.Timeline, &blockchainv1.StakeEntry{
+			Block:     int64(entry.Block),
+			Event:     entry.Event,
+			Delta:     entry.Delta,
+			Bonded:    entry.Bonded,
+			Unbonding: entry.Unbonding,
+			Withdrawn: entry.Withdrawn,
+			Slashed:   entry.Slashed,
+			State:     string(entry.State),
+		})
+	}
+	for _, transition := range l.Transitions {
+		ledger.Transitions = append(ledger.Transitions, &blockchainv1.StakeTransition{
+			Block: int64(transition.Block),
+			Event: transition.Event,
+			From:  string(transition.From),
+			To:    string(transition.To),
+		})
+	}
+	return ledger
+}
+
+// toEventStats converts event statistics into their protobuf message
+func toEventStats(s *input.EventStats) *blockchainv1.EventStats {
+	stats := &blockchainv1.EventStats{
+		TotalEvents:      int32(s.TotalEvents),
+		EventsByType:     toCounts(s.EventsByType),
+		EventsByCategory: toCounts(s.EventsByCategory),
+		EventsByBlock:    make(map[int64]int32, len(s.EventsByBlock)),
+		TotalAmount:      s.TotalAmount,
+		UniqueValidators: s.UniqueValidators,
+	}
+	for block, count := range s.EventsByBlock {
+		stats.EventsByBlock[int64(block)] = int32(count)
+	}
+	return stats
+}
+
+// toCounts converts a counter map into its protobuf representation
+func toCounts(counts map[string]int) map[string]int32 {
+	result := make(map[string]int32, len(counts))
+	for key, count := range counts {
+		result[key] = int32(count)
+	}
+	return result
+}
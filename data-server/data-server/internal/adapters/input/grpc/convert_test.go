@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"data-server/internal/domain/entities"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceErrorHidesTheCauseOfInternalErrors(t *testing.T) {
+	ctx := context.Background()
+
+	internal := status.Convert(serviceError(ctx, errors.New("open /data/validators.json: permission denied")))
+	if internal.Code() != codes.Internal || internal.Message() != "internal error" {
+		t.Errorf("internal error = %s %q, want Internal with a generic message", internal.Code(), internal.Message())
+	}
+
+	notFound := status.Convert(serviceError(ctx, fmt.Errorf("%w: bad", entities.ErrValidatorNotFound)))
+	if notFound.Code() != codes.NotFound || notFound.Message() == "internal error" {
+		t.Errorf("domain error = %s %q, want NotFound with its message", notFound.Code(), notFound.Message())
+	}
+}
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+
+	blockchainv1 "data-server/api/blockchain/v1"
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EventServer serves the event gRPC service through the event service
+type EventServer struct {
+	blockchainv1.UnimplementedEventServiceServer
+	eventService  input.EventService
+	streamService input.StreamService
+}
+
+// NewEventServer creates a new event gRPC server
+func NewEventServer(eventService input.EventService, streamService input.StreamService) *EventServer {
+	return &EventServer{
+		eventService:  eventService,
+		streamService: streamService,
+	}
+}
+
+// ListEvents mirrors GET /api/v1/events
+func (s *EventServer) ListEvents(ctx context.Context, req *blockchainv1.ListEventsRequest) (*blockchainv1.EventPage, error) {
+	query, err := eventQuery(req.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	page, err := pageRequest(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.eventService.QueryEvents(ctx, query, page)
+	if err != nil {
+		return nil, serviceError(ctx, err)
+	}
+	return toEventPage(ctx, events)
+}
+
+// GetEventStats mirrors GET /api/v1/events/stats
+func (s *EventServer) GetEventStats(ctx context.Context, req *blockchainv1.GetEventStatsRequest) (*blockchainv1.EventStats, error) {
+	stats, err := s.eventService.GetEventStats(ctx)
+	if err != nil {
+		return nil, serviceError(ctx, err)
+	}
+	return toEventStats(stats), nil
+}
+
+// StreamEvents sends new events matching the query as they are saved, replaying the
+// stored events after the requested last event id first, until the client cancels
+func (s *EventServer) StreamEvents(req *blockchainv1.StreamEventsRequest, stream blockchainv1.EventService_StreamEventsServer) error {
+	ctx := stream.Context()
+
+	query, err := eventQuery(req.GetQuery())
+	if err != nil {
+		return err
+	}
+
+	var after *valueobjects.CursorKey
+	if req.GetLastEventId() != "" {
+		position, err := valueobjects.ParseEventID(req.GetLastEventId())
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		after = &position
+	}
+
+	events, err := s.streamService.StreamEvents(ctx, query, after)
+	if err != nil {
+		return serviceError(ctx, err)
+	}
+
+	for e := range events {
+		event, err := toEvent(e.Event)
+		if err != nil {
+			return serviceError(ctx, err)
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+	// The stream fell too far behind or the server is shutting down, the client resumes
+	// from its last event
+	return serviceError(ctx, entities.ErrStreamInterrupted)
+}
@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"data-server/pkg/logger"
+
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// requestIDMetadata carries the identifier of a call, given by the client or the server
+const requestIDMetadata = "x-request-id"
+
+// Logger identifies every gRPC call by the x-request-id metadata of the client, when
+// valid, or else by a random identifier sent back in the response header, and logs the
+// call once served
+type Logger struct{}
+
+// NewLogger creates a new call logger
+func NewLogger() *Logger {
+	return &Logger{}
+}
+
+// ServerOptions returns the options installing the logger on a gRPC server. They must
+// precede the other options, so that the records they log carry the request identifier
+func (l *Logger) ServerOptions() []grpclib.ServerOption {
+	return []grpclib.ServerOption{
+		grpclib.ChainUnaryInterceptor(l.unaryInterceptor),
+		grpclib.ChainStreamInterceptor(l.streamInterceptor),
+	}
+}
+
+// unaryInterceptor logs unary calls
+func (l *Logger) unaryInterceptor(ctx context.Context, req interface{}, info *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	ctx = l.identify(ctx, info.FullMethod)
+
+	resp, err := handler(ctx, req)
+	l.log(ctx, start, err)
+	return resp, err
+}
+
+// streamInterceptor logs streaming calls once the stream ends
+func (l *Logger) streamInterceptor(srv interface{}, stream grpclib.ServerStream, info *grpclib.StreamServerInfo, handler grpclib.StreamHandler) error {
+	start := time.Now()
+	ctx := l.identify(stream.Context(), info.FullMethod)
+
+	err := handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	l.log(ctx, start, err)
+	return err
+}
+
+// identify returns a copy of the context carrying the request identifier and method of
+// the call, and sends the identifier in the response header
+func (l *Logger) identify(ctx context.Context, method string) context.Context {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestIDMetadata); len(ids) > 0 {
+			id = ids[0]
+		}
+	}
+	if !logger.ValidRequestID(id) {
+		id = logger.NewRequestID()
+	}
+	_ = grpclib.SetHeader(ctx, metadata.Pairs(requestIDMetadata, id))
+
+	ctx = logger.WithRequestID(ctx, id)
+	return logger.With(ctx, slog.String("rpc", method))
+}
+
+// log logs a served call, at the error level for server errors, the warn level for
+// client errors and the info level otherwise
+func (l *Logger) log(ctx context.Context, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch {
+	case serverError(code):
+		level = slog.LevelError
+	case code != codes.OK && code != codes.Canceled:
+		level = slog.LevelWarn
+	}
+
+	slog.LogAttrs(ctx, level, "Call served",
+		slog.String("code", code.String()),
+		slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+		slog.String("client_ip", peerIP(ctx)),
+	)
+}
+
+// serverError returns true if a status code reports a failure of the server rather than
+// of the call
+func serverError(code codes.Code) bool {
+	switch code {
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss, codes.Unimplemented:
+		return true
+	}
+	return false
+}
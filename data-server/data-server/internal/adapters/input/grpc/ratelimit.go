@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	blockchainv1 "data-server/api/blockchain/v1"
+	"data-server/internal/domain/entities"
+	"data-server/pkg/ratelimit"
+
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// RateLimiter limits the calls of every client with the token buckets and the costs
+// shared with the HTTP API. Calls cost their full method name plus the block range of
+// their event query, streams are charged once when their request is received
+type RateLimiter struct {
+	limiter *ratelimit.Limiter
+	policy  ratelimit.Policy
+	costs   ratelimit.Costs
+}
+
+// NewRateLimiter creates a new rate limiter
+func NewRateLimiter(limiter *ratelimit.Limiter, policy ratelimit.Policy, costs ratelimit.Costs) *RateLimiter {
+	return &RateLimiter{
+		limiter: limiter,
+		policy:  policy,
+		costs:   costs,
+	}
+}
+
+// ServerOptions returns the options installing the rate limiter on a gRPC server. They
+// must follow the options of the authenticator, which identifies the clients
+func (l *RateLimiter) ServerOptions() []grpclib.ServerOption {
+	return []grpclib.ServerOption{
+		grpclib.ChainUnaryInterceptor(l.unaryInterceptor),
+		grpclib.ChainStreamInterceptor(l.streamInterceptor),
+	}
+}
+
+// unaryInterceptor limits unary calls
+func (l *RateLimiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (interface{}, error) {
+	if err := l.allow(ctx, l.costs.Cost(info.FullMethod, blockRangeSpan(req))); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamInterceptor limits streaming calls
+func (l *RateLimiter) streamInterceptor(srv interface{}, stream grpclib.ServerStream, info *grpclib.StreamServerInfo, handler grpclib.StreamHandler) error {
+	return handler(srv, &chargedStream{
+		ServerStream: stream,
+		charge: func(req interface{}) error {
+			return l.allow(stream.Context(), l.costs.Cost(info.FullMethod, blockRangeSpan(req)))
+		},
+	})
+}
+
+// allow takes the cost of a call from the bucket of its client
+func (l *RateLimiter) allow(ctx context.Context, cost int) error {
+	keyID, keyRateLimit := "", 0
+	if principal, ok := entities.PrincipalFromContext(ctx); ok {
+		keyID, keyRateLimit = principal.KeyID, principal.RateLimit
+	}
+
+	client, rate := l.policy.Client(keyID, keyRateLimit, peerIP(ctx))
+	decision := l.limiter.Allow(client, rate, cost)
+	if !decision.Allowed {
+		return serviceError(ctx, fmt.Errorf("%w: retry in %s", entities.ErrRateLimited, decision.RetryAfter.Round(time.Millisecond)))
+	}
+	return nil
+}
+
+// chargedStream charges a streaming call once its request is received, the cost
+// depending on the block range of the request
+type chargedStream struct {
+	grpclib.ServerStream
+	charge  func(req interface{}) error
+	charged bool
+}
+
+// RecvMsg receives a message of the call, charging the call for the first one
+func (s *chargedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.charged {
+		return nil
+	}
+	s.charged = true
+	return s.charge(m)
+}
+
+// blockRangeSpan returns the number of blocks of the block range of the event query of
+// a request, and zero if it has none
+func blockRangeSpan(req interface{}) int {
+	queried, ok := req.(interface {
+		GetQuery() *blockchainv1.EventQuery
+	})
+	if !ok {
+		return 0
+	}
+
+	query := queried.GetQuery()
+	if query == nil || query.StartBlock == nil || query.EndBlock == nil || query.GetEndBlock() <= query.GetStartBlock() {
+		return 0
+	}
+	return int(query.GetEndBlock() - query.GetStartBlock())
+}
+
+// peerIP returns the IP address of the client of a call
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	blockchainv1 "data-server/api/blockchain/v1"
+	"data-server/internal/ports/input"
+
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// NewServer creates a gRPC server exposing the validator and event services. Server
+// reflection is enabled so tools such as grpcurl can discover the services
+func NewServer(validatorService input.ValidatorService, eventService input.EventService, streamService input.StreamService, opts ...grpclib.ServerOption) *grpclib.Server {
+	server := grpclib.NewServer(opts...)
+	blockchainv1.RegisterValidatorServiceServer(server, NewValidatorServer(validatorService))
+	blockchainv1.RegisterEventServiceServer(server, NewEventServer(eventService, streamService))
+	reflection.Register(server)
+	return server
+}
@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"data-server/pkg/logger"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tracerName names the tracer of the gRPC calls
+const tracerName = "data-server/internal/adapters/input/grpc"
+
+// Tracer traces every gRPC call in a server span, continuing the trace of the
+// traceparent metadata of the client
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer creates a new call tracer
+func NewTracer() *Tracer {
+	return &Tracer{
+		tracer: otel.Tracer(tracerName),
+	}
+}
+
+// ServerOptions returns the options installing the tracer on a gRPC server. They must
+// precede the other options, so that the spans and records of the call belong to its
+// trace
+func (t *Tracer) ServerOptions() []grpclib.ServerOption {
+	return []grpclib.ServerOption{
+		grpclib.ChainUnaryInterceptor(t.unaryInterceptor),
+		grpclib.ChainStreamInterceptor(t.streamInterceptor),
+	}
+}
+
+// unaryInterceptor traces unary calls
+func (t *Tracer) unaryInterceptor(ctx context.Context, req interface{}, info *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (interface{}, error) {
+	ctx, span := t.start(ctx, info.FullMethod)
+	resp, err := handler(ctx, req)
+	t.end(span, err)
+	return resp, err
+}
+
+// streamInterceptor traces streaming calls until the stream ends
+func (t *Tracer) streamInterceptor(srv interface{}, stream grpclib.ServerStream, info *grpclib.StreamServerInfo, handler grpclib.StreamHandler) error {
+	ctx, span := t.start(stream.Context(), info.FullMethod)
+	err := handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	t.end(span, err)
+	return err
+}
+
+// start starts the span of a call, returning a copy of the context carrying it and its
+// identifiers in the log fields
+func (t *Tracer) start(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	ctx, span := t.tracer.Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		),
+	)
+
+	if spanContext := span.SpanContext(); spanContext.IsValid() {
+		ctx = logger.With(ctx, slog.String("trace_id", spanContext.TraceID().String()), slog.String("span_id", spanContext.SpanID().String()))
+	}
+	return ctx, span
+}
+
+// end ends the span of a call with its status code, marking it as failed on server errors
+func (t *Tracer) end(span trace.Span, err error) {
+	code := status.Code(err)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+	if serverError(code) {
+		span.SetStatus(otelcodes.Error, code.String())
+	}
+	span.End()
+}
+
+// metadataCarrier adapts the metadata of a call to the carrier of the propagators
+type metadataCarrier metadata.MD
+
+// Get returns the first value of a key
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Set sets the value of a key
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys returns the keys of the metadata
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
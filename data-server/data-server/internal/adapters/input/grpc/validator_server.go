@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+
+	blockchainv1 "data-server/api/blockchain/v1"
+	"data-server/internal/ports/input"
+)
+
+// ValidatorServer serves the validator gRPC service through the validator service
+type ValidatorServer struct {
+	blockchainv1.UnimplementedValidatorServiceServer
+	validatorService input.ValidatorService
+}
+
+// NewValidatorServer creates a new validator gRPC server
+func NewValidatorServer(validatorService input.ValidatorService) *ValidatorServer {
+	return &ValidatorServer{
+		validatorService: validatorService,
+	}
+}
+
+// ListValidators mirrors GET /api/v1/validators
+func (s *ValidatorServer) ListValidators(ctx context.Context, req *blockchainv1.ListValidatorsRequest) (*blockchainv1.ListValidatorsResponse, error) {
+	page, err := pageRequest(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := s.validatorService.GetAllValidators(ctx, page)
+	if err != nil {
+		return nil, serviceError(ctx, err)
+	}
+
+	response := &blockchainv1.ListValidatorsResponse{
+		Validators: make([]*blockchainv1.Validator, 0, len(validators.Items)),
+		PageInfo:   toPageInfo(validators.Info),
+	}
+	for _, validator := range validators.Items {
+		response.Validators = append(response.Validators, toValidator(validator))
+	}
+	return response, nil
+}
+
+// GetValidator mirrors GET /api/v1/validators/{type}
+func (s *ValidatorServer) GetValidator(ctx context.Context, req *blockchainv1.GetValidatorRequest) (*blockchainv1.Validator, error) {
+	validator, err := s.validatorService.GetValidatorByType(ctx, req.GetType())
+	if err != nil {
+		return nil, serviceError(ctx, err)
+	}
+	return toValidator(validator), nil
+}
+
+// ListValidatorEvents mirrors GET /api/v1/validators/{type}/events
+func (s *ValidatorServer) ListValidatorEvents(ctx context.Context, req *blockchainv1.ListValidatorEventsRequest) (*blockchainv1.EventPage, error) {
+	query, err := eventQuery(req.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	page, err := pageRequest(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.validatorService.QueryValidatorEvents(ctx, req.GetType(), query, page)
+	if err != nil {
+		return nil, serviceError(ctx, err)
+	}
+	return toEventPage(ctx, events)
+}
+
+// GetValidatorStats mirrors GET /api/v1/validators/{type}/stats
+func (s *ValidatorServer) GetValidatorStats(ctx context.Context, req *blockchainv1.GetValidatorRequest) (*blockchainv1.ValidatorStats, error) {
+	stats, err := s.validatorService.GetValidatorStats(ctx, req.GetType())
+	if err != nil {
+		return nil, serviceError(ctx, err)
+	}
+	return toValidatorStats(stats), nil
+}
+
+// GetValidatorPayouts mirrors GET /api/v1/validators/{type}/payouts
+func (s *ValidatorServer) GetValidatorPayouts(ctx context.Context, req *blockchainv1.GetValidatorRequest) (*blockchainv1.PayoutReport, error) {
+	report, err := s.validatorService.GetValidatorPayouts(ctx, req.GetType())
+	if err != nil {
+		return nil, serviceError(ctx, err)
+	}
+	return toPayoutReport(report), nil
+}
+
+// GetValidatorStake mirrors GET /api/v1/validators/{type}/stake
+func (s *ValidatorServer) GetValidatorStake(ctx context.Context, req *blockchainv1.GetValidatorRequest) (*blockchainv1.StakeLedger, error) {
+	ledger, err := s.validatorService.GetValidatorStake(ctx, req.GetType())
+	if err != nil {
+		return nil, serviceError(ctx, err)
+	}
+	return toStakeLedger(ledger), nil
+}
@@ -0,0 +1,70 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// csvWriter writes rows as comma separated values with a header row
+type csvWriter struct {
+	writer  *csv.Writer
+	columns []Column
+	record  []string
+}
+
+// newCSVWriter creates a CSV writer and writes the header row
+func newCSVWriter(output io.Writer, columns []Column) (*csvWriter, error) {
+	w := &csvWriter{
+		writer:  csv.NewWriter(output),
+		columns: columns,
+		record:  make([]string, len(columns)),
+	}
+
+	for i, column := range columns {
+		w.record[i] = column.Name
+	}
+	if err := w.writer.Write(w.record); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// WriteRow writes a row
+func (w *csvWriter) WriteRow(values []interface{}) error {
+	for i, column := range w.columns {
+		w.record[i] = ""
+		value, ok := coerce(column.Type, values[i])
+		if !ok {
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			w.record[i] = v
+		case int64:
+			w.record[i] = strconv.FormatInt(v, 10)
+		case bool:
+			w.record[i] = strconv.FormatBool(v)
+		case time.Time:
+			w.record[i] = v.Format(time.RFC3339Nano)
+		case json.RawMessage:
+			w.record[i] = string(v)
+		}
+	}
+
+	return w.writer.Write(w.record)
+}
+
+// Flush writes the buffered rows to the output
+func (w *csvWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// Close writes the remaining rows to the output
+func (w *csvWriter) Close() error {
+	return w.Flush()
+}
@@ -0,0 +1,68 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// ndjsonWriter writes rows as newline delimited JSON objects keyed by column name, in
+// column order
+type ndjsonWriter struct {
+	writer  *bufio.Writer
+	columns []Column
+	keys    [][]byte
+}
+
+// newNDJSONWriter creates a newline delimited JSON writer
+func newNDJSONWriter(output io.Writer, columns []Column) *ndjsonWriter {
+	w := &ndjsonWriter{
+		writer:  bufio.NewWriter(output),
+		columns: columns,
+		keys:    make([][]byte, len(columns)),
+	}
+
+	for i, column := range columns {
+		w.keys[i], _ = json.Marshal(column.Name)
+	}
+
+	return w
+}
+
+// WriteRow writes a row as a JSON object on its own line
+func (w *ndjsonWriter) WriteRow(values []interface{}) error {
+	w.writer.WriteByte('{')
+	for i, column := range w.columns {
+		if i > 0 {
+			w.writer.WriteByte(',')
+		}
+		w.writer.Write(w.keys[i])
+		w.writer.WriteByte(':')
+
+		value, ok := coerce(column.Type, values[i])
+		if !ok {
+			w.writer.WriteString("null")
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		w.writer.Write(data)
+	}
+	w.writer.WriteString("}\n")
+
+	// Errors of the buffered writes above are sticky and reported here
+	_, err := w.writer.Write(nil)
+	return err
+}
+
+// Flush writes the buffered rows to the output
+func (w *ndjsonWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+// Close writes the remaining rows to the output
+func (w *ndjsonWriter) Close() error {
+	return w.Flush()
+}
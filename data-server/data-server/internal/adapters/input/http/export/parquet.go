@@ -0,0 +1,106 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// parquetRowGroupSize is the maximum number of rows buffered before a row group is
+// written to the output
+const parquetRowGroupSize = 10000
+
+// parquetWriter writes rows to a Parquet file of optional columns
+type parquetWriter struct {
+	writer  *parquet.Writer
+	columns []Column
+	leaves  []int
+	row     parquet.Row
+}
+
+// newParquetWriter creates a Parquet writer with a schema built from the columns
+func newParquetWriter(output io.Writer, columns []Column) *parquetWriter {
+	group := parquet.Group{}
+	for _, column := range columns {
+		group[column.Name] = parquet.Optional(parquetNode(column.Type))
+	}
+	schema := parquet.NewSchema("export", group)
+
+	// Parquet orders the columns of a group by name
+	leaves := map[string]int{}
+	for i, path := range schema.Columns() {
+		leaves[path[0]] = i
+	}
+	w := &parquetWriter{
+		writer: parquet.NewWriter(output, schema,
+			parquet.Compression(&parquet.Snappy),
+			parquet.MaxRowsPerRowGroup(parquetRowGroupSize),
+		),
+		columns: columns,
+		leaves:  make([]int, len(columns)),
+		row:     make(parquet.Row, len(columns)),
+	}
+	for i, column := range columns {
+		w.leaves[i] = leaves[column.Name]
+	}
+
+	return w
+}
+
+// parquetNode returns the Parquet type of a column type
+func parquetNode(columnType ColumnType) parquet.Node {
+	switch columnType {
+	case ColumnInteger:
+		return parquet.Int(64)
+	case ColumnBoolean:
+		return parquet.Leaf(parquet.BooleanType)
+	case ColumnTimestamp:
+		return parquet.Timestamp(parquet.Millisecond)
+	case ColumnJSON:
+		return parquet.JSON()
+	default:
+		return parquet.String()
+	}
+}
+
+// WriteRow buffers a row in the current row group
+func (w *parquetWriter) WriteRow(values []interface{}) error {
+	for i, column := range w.columns {
+		leaf := w.leaves[i]
+		value, ok := coerce(column.Type, values[i])
+		if !ok {
+			w.row[leaf] = parquet.NullValue().Level(0, 0, leaf)
+			continue
+		}
+
+		var v parquet.Value
+		switch value := value.(type) {
+		case string:
+			v = parquet.ByteArrayValue([]byte(value))
+		case int64:
+			v = parquet.Int64Value(value)
+		case bool:
+			v = parquet.BooleanValue(value)
+		case time.Time:
+			v = parquet.Int64Value(value.UnixMilli())
+		case json.RawMessage:
+			v = parquet.ByteArrayValue(value)
+		}
+		w.row[leaf] = v.Level(0, 1, leaf)
+	}
+
+	_, err := w.writer.WriteRows([]parquet.Row{w.row})
+	return err
+}
+
+// Flush does nothing, rows are written to the output a row group at a time
+func (w *parquetWriter) Flush() error {
+	return nil
+}
+
+// Close writes the last row group and the file footer
+func (w *parquetWriter) Close() error {
+	return w.writer.Close()
+}
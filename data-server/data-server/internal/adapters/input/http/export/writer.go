@@ -0,0 +1,191 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format represents an export file format
+type Format string
+
+const (
+	FormatCSV     Format = "csv"
+	FormatNDJSON  Format = "ndjson"
+	FormatParquet Format = "parquet"
+)
+
+// mediaTypes maps the accepted media types to their format, the first one of each format
+// is used as the response content type
+var mediaTypes = []struct {
+	mediaType string
+	format    Format
+}{
+	{"text/csv", FormatCSV},
+	{"application/x-ndjson", FormatNDJSON},
+	{"application/jsonl", FormatNDJSON},
+	{"application/vnd.apache.parquet", FormatParquet},
+	{"application/x-parquet", FormatParquet},
+}
+
+// ParseFormat parses a format name as given in the format query parameter
+func ParseFormat(name string) (Format, bool) {
+	switch Format(strings.ToLower(name)) {
+	case FormatCSV:
+		return FormatCSV, true
+	case FormatNDJSON, "jsonl":
+		return FormatNDJSON, true
+	case FormatParquet:
+		return FormatParquet, true
+	}
+	return "", false
+}
+
+// NegotiateFormat returns the format of the most preferred supported media type of an
+// Accept header. CSV is used when the header is empty or accepts any type
+func NegotiateFormat(accept string) (Format, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return FormatCSV, true
+	}
+
+	type candidate struct {
+		mediaType string
+		quality   float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		c := candidate{mediaType: strings.ToLower(strings.TrimSpace(params[0])), quality: 1}
+		for _, param := range params[1:] {
+			key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if key == "q" {
+				if q, err := strconv.ParseFloat(value, 64); err == nil {
+					c.quality = q
+				}
+			}
+		}
+		if c.quality > 0 {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+
+	for _, c := range candidates {
+		if c.mediaType == "*/*" || c.mediaType == "text/*" {
+			return FormatCSV, true
+		}
+		for _, m := range mediaTypes {
+			if m.mediaType == c.mediaType {
+				return m.format, true
+			}
+		}
+	}
+	return "", false
+}
+
+// ContentType returns the media type of the format
+func (f Format) ContentType() string {
+	for _, m := range mediaTypes {
+		if m.format == f {
+			return m.mediaType
+		}
+	}
+	return "application/octet-stream"
+}
+
+// ColumnType represents the type of the values of a column
+type ColumnType int
+
+const (
+	ColumnString ColumnType = iota
+	ColumnInteger
+	ColumnBoolean
+	ColumnTimestamp
+	// ColumnJSON holds lists and objects, encoded as JSON text in CSV and Parquet
+	ColumnJSON
+)
+
+// Column represents a column of an export
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+// Writer writes the rows of an export. Row values are given in column order, nil and
+// values that do not match the column type are written as nulls
+type Writer interface {
+	// WriteRow writes a row
+	WriteRow(values []interface{}) error
+
+	// Flush writes the buffered rows to the output where the format allows it
+	Flush() error
+
+	// Close flushes the remaining rows and completes the file
+	Close() error
+}
+
+// NewWriter creates a writer of the given format
+func NewWriter(format Format, output io.Writer, columns []Column) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(output, columns)
+	case FormatNDJSON:
+		return newNDJSONWriter(output, columns), nil
+	case FormatParquet:
+		return newParquetWriter(output, columns), nil
+	}
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+// coerce converts a value to the Go type of the column type, returning false for nil
+// and mismatching values
+func coerce(columnType ColumnType, value interface{}) (interface{}, bool) {
+	if value == nil {
+		return nil, false
+	}
+
+	switch columnType {
+	case ColumnString:
+		if s, ok := value.(string); ok {
+			return s, true
+		}
+		return fmt.Sprint(value), true
+	case ColumnInteger:
+		switch v := value.(type) {
+		case int:
+			return int64(v), true
+		case int32:
+			return int64(v), true
+		case int64:
+			return v, true
+		case uint32:
+			return int64(v), true
+		case uint64:
+			return int64(v), true
+		case float64:
+			return int64(v), true
+		}
+	case ColumnBoolean:
+		if b, ok := value.(bool); ok {
+			return b, true
+		}
+	case ColumnTimestamp:
+		if t, ok := value.(time.Time); ok && !t.IsZero() {
+			return t.UTC(), true
+		}
+	case ColumnJSON:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, false
+		}
+		return json.RawMessage(data), true
+	}
+
+	return nil, false
+}
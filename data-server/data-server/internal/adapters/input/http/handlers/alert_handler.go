@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"net/http"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AlertHandler handles alert and alert rule HTTP requests
+type AlertHandler struct {
+	alertService input.AlertService
+}
+
+// NewAlertHandler creates a new alert handler
+func NewAlertHandler(alertService input.AlertService) *AlertHandler {
+	return &AlertHandler{
+		alertService: alertService,
+	}
+}
+
+// GetAlerts handles GET /api/v1/alerts
+func (h *AlertHandler) GetAlerts(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := entities.AlertQuery{
+		State:       entities.AlertState(c.Query("state")),
+		RuleID:      c.Query("rule"),
+		Stash:       c.Query("stash"),
+		MinSeverity: entities.IncidentSeverity(c.Query("severity")),
+	}
+	if query.State != "" && query.State != entities.AlertStateFiring && query.State != entities.AlertStateResolved {
+		response.BadRequest(c, "Invalid state, expected one of firing, resolved")
+		return
+	}
+	if query.MinSeverity != "" && !entities.IsValidIncidentSeverity(string(query.MinSeverity)) {
+		response.BadRequest(c, "Invalid severity, expected one of low, medium, high, critical")
+		return
+	}
+
+	page, ok := parsePageQuery(c)
+	if !ok {
+		return
+	}
+
+	alerts, err := h.alertService.GetAlerts(ctx, query, page)
+	if err != nil {
+		respondError(c, "Failed to retrieve alerts", err)
+		return
+	}
+
+	respondPage(c, alerts)
+}
+
+// GetAlert handles GET /api/v1/alerts/:id
+func (h *AlertHandler) GetAlert(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	alert, err := h.alertService.GetAlert(ctx, id)
+	if err != nil {
+		respondError(c, "Failed to retrieve alert", err)
+		return
+	}
+
+	response.Success(c, alert)
+}
+
+// GetAlertRules handles GET /api/v1/alerts/rules
+func (h *AlertHandler) GetAlertRules(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	page, ok := parsePageQuery(c)
+	if !ok {
+		return
+	}
+
+	rules, err := h.alertService.GetAlertRules(ctx, page)
+	if err != nil {
+		respondError(c, "Failed to retrieve alert rules", err)
+		return
+	}
+
+	respondPage(c, rules)
+}
+
+// GetAlertRule handles GET /api/v1/alerts/rules/:id
+func (h *AlertHandler) GetAlertRule(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	rule, err := h.alertService.GetAlertRule(ctx, id)
+	if err != nil {
+		respondError(c, "Failed to retrieve alert rule", err)
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+// CreateAlertRule handles POST /api/v1/alerts/rules
+func (h *AlertHandler) CreateAlertRule(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var request input.AlertRuleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid alert rule request body", err)
+		return
+	}
+
+	rule, err := h.alertService.CreateAlertRule(ctx, request)
+	if err != nil {
+		respondError(c, "Failed to create alert rule", err)
+		return
+	}
+
+	response.Created(c, rule)
+}
+
+// UpdateAlertRule handles PUT /api/v1/alerts/rules/:id
+func (h *AlertHandler) UpdateAlertRule(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	var request input.AlertRuleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid alert rule request body", err)
+		return
+	}
+
+	rule, err := h.alertService.UpdateAlertRule(ctx, id, request)
+	if err != nil {
+		respondError(c, "Failed to update alert rule", err)
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+// DeleteAlertRule handles DELETE /api/v1/alerts/rules/:id
+func (h *AlertHandler) DeleteAlertRule(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if err := h.alertService.DeleteAlertRule(ctx, id); err != nil {
+		respondError(c, "Failed to delete alert rule", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"net/http"
+
+	"data-server/internal/ports/input"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHandler handles API key management HTTP requests
+type APIKeyHandler struct {
+	apiKeyService input.APIKeyService
+}
+
+// NewAPIKeyHandler creates a new API key handler
+func NewAPIKeyHandler(apiKeyService input.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+// CreateAPIKey handles POST /api/v1/admin/keys
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var request input.APIKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid API key request body", err)
+		return
+	}
+
+	key, err := h.apiKeyService.CreateAPIKey(ctx, request)
+	if err != nil {
+		respondError(c, "Failed to create API key", err)
+		return
+	}
+
+	response.Created(c, key)
+}
+
+// GetAPIKeys handles GET /api/v1/admin/keys
+func (h *APIKeyHandler) GetAPIKeys(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	page, ok := parsePageQuery(c)
+	if !ok {
+		return
+	}
+
+	keys, err := h.apiKeyService.GetAPIKeys(ctx, page)
+	if err != nil {
+		respondError(c, "Failed to retrieve API keys", err)
+		return
+	}
+
+	respondPage(c, keys)
+}
+
+// GetAPIKey handles GET /api/v1/admin/keys/:id
+func (h *APIKeyHandler) GetAPIKey(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	key, err := h.apiKeyService.GetAPIKey(ctx, id)
+	if err != nil {
+		respondError(c, "Failed to retrieve API key", err)
+		return
+	}
+
+	response.Success(c, key)
+}
+
+// UpdateAPIKey handles PUT /api/v1/admin/keys/:id
+func (h *APIKeyHandler) UpdateAPIKey(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	var request input.APIKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid API key request body", err)
+		return
+	}
+
+	key, err := h.apiKeyService.UpdateAPIKey(ctx, id, request)
+	if err != nil {
+		respondError(c, "Failed to update API key", err)
+		return
+	}
+
+	response.Success(c, key)
+}
+
+// DeleteAPIKey handles DELETE /api/v1/admin/keys/:id
+func (h *APIKeyHandler) DeleteAPIKey(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if err := h.apiKeyService.DeleteAPIKey(ctx, id); err != nil {
+		respondError(c, "Failed to delete API key", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"data-server/internal/config"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ConfigHandler handles configuration HTTP requests
+type ConfigHandler struct {
+	config config.Config
+}
+
+// NewConfigHandler creates a new configuration handler, showing the configuration
+// without its secrets
+func NewConfigHandler(cfg *config.Config) *ConfigHandler {
+	return &ConfigHandler{
+		config: cfg.Redacted(),
+	}
+}
+
+// GetConfig handles GET /api/v1/admin/config
+func (h *ConfigHandler) GetConfig(c *gin.Context) {
+	response.Success(c, h.config)
+}
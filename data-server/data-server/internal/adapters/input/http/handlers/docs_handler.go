@@ -4,7 +4,7 @@ import (
 	"embed"
 	"html/template"
 	"net/http"
-	"os"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"gopkg.in/yaml.v3"
@@ -16,19 +16,16 @@ var templates embed.FS
 // DocsHandler handles API documentation requests
 type DocsHandler struct {
 	openAPISpec []byte
+	baseURL     string
 }
 
-// NewDocsHandler creates a new documentation handler
-func NewDocsHandler() (*DocsHandler, error) {
-	// Read the OpenAPI spec file
-	specData, err := os.ReadFile("docs/openapi.yaml")
-	if err != nil {
-		return nil, err
-	}
-
+// NewDocsHandler creates a new documentation handler serving the given OpenAPI spec. An
+// empty base URL is derived from the host of every request
+func NewDocsHandler(openAPISpec []byte, baseURL string) *DocsHandler {
 	return &DocsHandler{
-		openAPISpec: specData,
-	}, nil
+		openAPISpec: openAPISpec,
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+	}
 }
 
 // ServeOpenAPISpec serves the raw OpenAPI specification
@@ -56,7 +53,7 @@ func (h *DocsHandler) ServeDocsUI(c *gin.Context) {
 		"description": info["description"],
 		"version":     info["version"],
 		"paths":       paths,
-		"baseURL":     "http://localhost:8080",
+		"baseURL":     h.requestBaseURL(c),
 	}
 
 	// Parse and execute the template
@@ -70,6 +67,20 @@ func (h *DocsHandler) ServeDocsUI(c *gin.Context) {
 	tmpl.Execute(c.Writer, data)
 }
 
+// requestBaseURL returns the configured base URL, or the scheme and host the request was
+// sent to
+func (h *DocsHandler) requestBaseURL(c *gin.Context) string {
+	if h.baseURL != "" {
+		return h.baseURL
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host
+}
+
 // ServeDocsCSS serves the CSS for the documentation UI
 func (h *DocsHandler) ServeDocsCSS(c *gin.Context) {
 	c.Header("Content-Type", "text/css")
@@ -312,7 +323,7 @@ func (h *DocsHandler) ServeDocsJS(c *gin.Context) {
 				btn.addEventListener('click', function() {
 					const path = this.getAttribute('data-path');
 					const method = this.getAttribute('data-method');
-					const baseURL = 'http://localhost:8080';
+					const baseURL = document.body.dataset.baseUrl;
 					const fullURL = baseURL + path;
 					
 					navigator.clipboard.writeText(fullURL).then(() => {
@@ -341,7 +352,7 @@ func (h *DocsHandler) ServeDocsJS(c *gin.Context) {
 				btn.addEventListener('click', function() {
 					const path = this.getAttribute('data-path');
 					const method = this.getAttribute('data-method');
-					const baseURL = 'http://localhost:8080';
+					const baseURL = document.body.dataset.baseUrl;
 					const fullURL = baseURL + path;
 					
 					// Open in new tab
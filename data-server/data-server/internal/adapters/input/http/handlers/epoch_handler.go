@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"data-server/internal/ports/input"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EpochHandler handles consensus epoch HTTP requests
+type EpochHandler struct {
+	epochService input.EpochService
+}
+
+// NewEpochHandler creates a new epoch handler
+func NewEpochHandler(epochService input.EpochService) *EpochHandler {
+	return &EpochHandler{
+		epochService: epochService,
+	}
+}
+
+// GetEpochs handles GET /api/v1/epochs
+func (h *EpochHandler) GetEpochs(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	page, ok := parsePageQuery(c)
+	if !ok {
+		return
+	}
+
+	epochs, err := h.epochService.GetEpochs(ctx, page)
+	if err != nil {
+		respondError(c, "Failed to retrieve epochs", err)
+		return
+	}
+
+	respondPage(c, epochs)
+}
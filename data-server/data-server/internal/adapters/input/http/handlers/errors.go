@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"data-server/internal/domain/domainerr"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// problemStatuses maps the kinds of domain errors to HTTP status codes
+var problemStatuses = map[domainerr.Kind]int{
+	domainerr.NotFound:        http.StatusNotFound,
+	domainerr.InvalidInput:    http.StatusBadRequest,
+	domainerr.InvalidRange:    http.StatusBadRequest,
+	domainerr.InvalidAddress:  http.StatusBadRequest,
+	domainerr.Unauthenticated: http.StatusUnauthorized,
+	domainerr.Forbidden:       http.StatusForbidden,
+	domainerr.RateLimited:     http.StatusTooManyRequests,
+	domainerr.Conflict:        http.StatusConflict,
+	domainerr.Unavailable:     http.StatusServiceUnavailable,
+}
+
+// respondError sends the problem details of an error returned by a service. Domain
+// errors get the status code of their kind and are titled by their message, any other
+// error is an internal server error with the given message
+func respondError(c *gin.Context, message string, err error) {
+	domainErr, ok := domainerr.As(err)
+	if !ok {
+		response.Error(c, http.StatusInternalServerError, message, err)
+		return
+	}
+
+	status, ok := problemStatuses[domainErr.Kind]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+	response.Error(c, status, problemTitle(domainErr), err)
+}
+
+// problemTitle returns the message of a domain error as a sentence case title
+func problemTitle(err *domainerr.Error) string {
+	if err.Message == "" {
+		return err.Message
+	}
+	return strings.ToUpper(err.Message[:1]) + err.Message[1:]
+}
+
+// RouteNotFound handles requests to routes that do not exist
+func RouteNotFound(c *gin.Context) {
+	response.Error(c, http.StatusNotFound, "Route not found", nil)
+}
+
+// MethodNotAllowed handles requests with a method a route does not support
+func MethodNotAllowed(c *gin.Context) {
+	response.Error(c, http.StatusMethodNotAllowed, "Method not allowed", nil)
+}
@@ -1,10 +1,8 @@
 package handlers
 
 import (
-	"net/http"
-	"strconv"
-
 	"github.com/gin-gonic/gin"
+	"data-server/internal/domain/entities"
 	"data-server/internal/ports/input"
 	"data-server/pkg/response"
 )
@@ -23,85 +21,87 @@ func NewEventHandler(eventService input.EventService) *EventHandler {
 
 // GetAllEvents handles GET /api/v1/events
 func (h *EventHandler) GetAllEvents(c *gin.Context) {
-	ctx := c.Request.Context()
-	
-	events, err := h.eventService.GetAllEvents(ctx)
-	if err != nil {
-		response.Error(c, http.StatusInternalServerError, "Failed to retrieve events", err)
-		return
-	}
-	
-	response.Success(c, events)
+	h.queryEvents(c, func(query *entities.EventQuery) {})
 }
 
 // GetEventsByType handles GET /api/v1/events/:eventType
 func (h *EventHandler) GetEventsByType(c *gin.Context) {
-	ctx := c.Request.Context()
 	eventType := c.Param("eventType")
 	
-	events, err := h.eventService.GetEventsByType(ctx, eventType)
-	if err != nil {
-		response.Error(c, http.StatusNotFound, "Events not found", err)
+	h.queryEvents(c, func(query *entities.EventQuery) {
+		query.Types = []string{eventType}
+	})
+}
+
+// GetEventsByBlockRange handles GET /api/v1/events/blocks/:start/:end
+func (h *EventHandler) GetEventsByBlockRange(c *gin.Context) {
+	blockRange, ok := parseBlockRangeParams(c)
+	if !ok {
 		return
 	}
 	
-	response.Success(c, events)
+	h.queryEvents(c, func(query *entities.EventQuery) {
+		query.BlockRange = blockRange
+	})
 }
 
-// GetEventsByBlockRange handles GET /api/v1/events/blocks/:start/:end
-func (h *EventHandler) GetEventsByBlockRange(c *gin.Context) {
-	ctx := c.Request.Context()
+// GetEventsByCategory handles GET /api/v1/events/category/:category
+func (h *EventHandler) GetEventsByCategory(c *gin.Context) {
+	category := c.Param("category")
 	
-	startBlockStr := c.Param("start")
-	endBlockStr := c.Param("end")
+	h.queryEvents(c, func(query *entities.EventQuery) {
+		query.Categories = []string{category}
+	})
+}
+
+// GetEventsByValidator handles GET /api/v1/events/validator/:stash. Unlike the stash
+// filter, it responds with not found when no validator has the stash
+func (h *EventHandler) GetEventsByValidator(c *gin.Context) {
+	ctx := c.Request.Context()
+	stash := c.Param("stash")
 	
-	startBlock, err := strconv.Atoi(startBlockStr)
-	if err != nil {
-		response.Error(c, http.StatusBadRequest, "Invalid start block", err)
+	query, ok := parseEventQuery(c)
+	if !ok {
 		return
 	}
 	
-	endBlock, err := strconv.Atoi(endBlockStr)
-	if err != nil {
-		response.Error(c, http.StatusBadRequest, "Invalid end block", err)
+	page, ok := parsePageQuery(c)
+	if !ok {
 		return
 	}
 	
-	events, err := h.eventService.GetEventsByBlockRange(ctx, startBlock, endBlock)
+	events, err := h.eventService.GetEventsByValidator(ctx, stash, query, page)
 	if err != nil {
-		response.Error(c, http.StatusNotFound, "Events not found", err)
+		respondError(c, "Failed to retrieve events", err)
 		return
 	}
 	
-	response.Success(c, events)
+	respondPage(c, events)
 }
 
-// GetEventsByCategory handles GET /api/v1/events/category/:category
-func (h *EventHandler) GetEventsByCategory(c *gin.Context) {
+// queryEvents parses the event query and page from the request, lets the route narrow
+// the query with its path parameters and responds with the matching page of events
+func (h *EventHandler) queryEvents(c *gin.Context, narrow func(query *entities.EventQuery)) {
 	ctx := c.Request.Context()
-	category := c.Param("category")
 	
-	events, err := h.eventService.GetEventsByCategory(ctx, category)
-	if err != nil {
-		response.Error(c, http.StatusNotFound, "Events not found", err)
+	query, ok := parseEventQuery(c)
+	if !ok {
 		return
 	}
+	narrow(&query)
 	
-	response.Success(c, events)
-}
-
-// GetEventsByValidator handles GET /api/v1/events/validator/:stash
-func (h *EventHandler) GetEventsByValidator(c *gin.Context) {
-	ctx := c.Request.Context()
-	stash := c.Param("stash")
+	page, ok := parsePageQuery(c)
+	if !ok {
+		return
+	}
 	
-	events, err := h.eventService.GetEventsByValidator(ctx, stash)
+	events, err := h.eventService.QueryEvents(ctx, query, page)
 	if err != nil {
-		response.Error(c, http.StatusNotFound, "Events not found", err)
+		respondError(c, "Failed to retrieve events", err)
 		return
 	}
 	
-	response.Success(c, events)
+	respondPage(c, events)
 }
 
 // GetEventStats handles GET /api/v1/events/stats
@@ -110,7 +110,7 @@ func (h *EventHandler) GetEventStats(c *gin.Context) {
 	
 	stats, err := h.eventService.GetEventStats(ctx)
 	if err != nil {
-		response.Error(c, http.StatusInternalServerError, "Failed to retrieve event stats", err)
+		respondError(c, "Failed to retrieve event stats", err)
 		return
 	}
 	
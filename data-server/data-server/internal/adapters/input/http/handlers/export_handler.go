@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"data-server/internal/adapters/input/http/export"
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportFlushInterval is the number of rows written between two flushes of the response
+const exportFlushInterval = 1000
+
+// eventExportColumns are the columns of every exported event, followed by its data
+var eventExportColumns = []export.Column{
+	{Name: "id", Type: export.ColumnString},
+	{Name: "stash", Type: export.ColumnString},
+	{Name: "block", Type: export.ColumnInteger},
+	{Name: "index", Type: export.ColumnInteger},
+	{Name: "event", Type: export.ColumnString},
+	{Name: "category", Type: export.ColumnString},
+	{Name: "timestamp", Type: export.ColumnTimestamp},
+	{Name: "hash", Type: export.ColumnString},
+}
+
+// validatorExportColumns are the columns of every exported validator summary
+var validatorExportColumns = []export.Column{
+	{Name: "stash", Type: export.ColumnString},
+	{Name: "type", Type: export.ColumnString},
+	{Name: "description", Type: export.ColumnString},
+	{Name: "total_events", Type: export.ColumnInteger},
+	{Name: "staking_events", Type: export.ColumnInteger},
+	{Name: "governance_events", Type: export.ColumnInteger},
+	{Name: "online_events", Type: export.ColumnInteger},
+	{Name: "offence_events", Type: export.ColumnInteger},
+	{Name: "total_rewards", Type: export.ColumnInteger},
+	{Name: "is_active", Type: export.ColumnBoolean},
+	{Name: "has_been_slashed", Type: export.ColumnBoolean},
+	{Name: "stake_state", Type: export.ColumnString},
+	{Name: "bonded_stake", Type: export.ColumnInteger},
+	{Name: "latest_block", Type: export.ColumnInteger},
+	{Name: "updated_at", Type: export.ColumnTimestamp},
+}
+
+// exportColumnTypes maps event field types to export column types
+var exportColumnTypes = map[entities.EventFieldType]export.ColumnType{
+	entities.EventFieldString:  export.ColumnString,
+	entities.EventFieldInteger: export.ColumnInteger,
+	entities.EventFieldBoolean: export.ColumnBoolean,
+	entities.EventFieldJSON:    export.ColumnJSON,
+}
+
+// ExportHandler handles bulk export requests
+type ExportHandler struct {
+	exportService input.ExportService
+}
+
+// NewExportHandler creates a new export handler
+func NewExportHandler(exportService input.ExportService) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportEvents handles GET /api/v1/export/events
+func (h *ExportHandler) ExportEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	format, ok := parseExportFormat(c)
+	if !ok {
+		return
+	}
+
+	query, ok := parseEventQuery(c)
+	if !ok {
+		return
+	}
+
+	// The data is flattened into columns when the schema of every requested type is
+	// known, and exported as a single JSON column otherwise
+	columns := append([]export.Column{}, eventExportColumns...)
+	fields, flattened := entities.EventSchema(query.Types)
+	if flattened {
+		for _, field := range fields {
+			columns = append(columns, export.Column{Name: "data." + field.Path, Type: exportColumnTypes[field.Type]})
+		}
+	} else {
+		columns = append(columns, export.Column{Name: "data", Type: export.ColumnJSON})
+	}
+
+	stream := newExportStream(c, format, "events", columns)
+	err := h.exportService.ExportEvents(ctx, query, func(event entities.ValidatorEvent) error {
+		row := []interface{}{
+			event.ID(),
+			event.Stash,
+			event.Block,
+			event.Index,
+			event.Event.Event,
+			event.GetEventCategory(),
+			event.Timestamp,
+			event.Hash,
+		}
+		if flattened {
+			for _, field := range fields {
+				value, _ := event.Field(field.Path)
+				row = append(row, value)
+			}
+		} else {
+			row = append(row, event.Data)
+		}
+		return stream.write(row)
+	})
+
+	if stream.failed(err) {
+		respondError(c, "Failed to export events", err)
+		return
+	}
+	stream.close(err)
+}
+
+// ExportValidators handles GET /api/v1/export/validators
+func (h *ExportHandler) ExportValidators(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	format, ok := parseExportFormat(c)
+	if !ok {
+		return
+	}
+
+	stream := newExportStream(c, format, "validators", validatorExportColumns)
+	err := h.exportService.ExportValidators(ctx, func(summary input.ValidatorSummary) error {
+		return stream.write([]interface{}{
+			summary.Stash,
+			summary.Type,
+			summary.Description,
+			summary.TotalEvents,
+			summary.StakingEvents,
+			summary.GovernanceEvents,
+			summary.OnlineEvents,
+			summary.OffenceEvents,
+			summary.TotalRewards,
+			summary.IsActive,
+			summary.HasBeenSlashed,
+			string(summary.StakeState),
+			summary.BondedStake,
+			summary.LatestBlock,
+			summary.UpdatedAt,
+		})
+	})
+
+	if stream.failed(err) {
+		respondError(c, "Failed to export validators", err)
+		return
+	}
+	stream.close(err)
+}
+
+// parseExportFormat returns the format requested by the format query parameter or,
+// without it, negotiated from the Accept header. It writes an error response and
+// returns false if the format is not supported
+func parseExportFormat(c *gin.Context) (export.Format, bool) {
+	if name, ok := c.GetQuery("format"); ok {
+		format, ok := export.ParseFormat(name)
+		if !ok {
+			response.BadRequest(c, "Invalid format, expected one of csv, ndjson, parquet")
+			return "", false
+		}
+		return format, true
+	}
+
+	format, ok := export.NegotiateFormat(c.GetHeader("Accept"))
+	if !ok {
+		response.Error(c, http.StatusNotAcceptable, "No acceptable export format, expected text/csv, application/x-ndjson or application/vnd.apache.parquet", nil)
+		return "", false
+	}
+	return format, true
+}
+
+// exportStream writes the rows of an export to the response. The response headers are
+// only sent with the first row so that errors found before can still be reported
+type exportStream struct {
+	c       *gin.Context
+	format  export.Format
+	name    string
+	columns []export.Column
+	writer  export.Writer
+	rows    int
+}
+
+// newExportStream creates an export stream of the given format, name and columns
+func newExportStream(c *gin.Context, format export.Format, name string, columns []export.Column) *exportStream {
+	return &exportStream{
+		c:       c,
+		format:  format,
+		name:    name,
+		columns: columns,
+	}
+}
+
+// start sends the response headers and creates the writer
+func (s *exportStream) start() error {
+	s.c.Header("Content-Type", s.format.ContentType())
+	s.c.Header("Content-Disposition", `attachment; filename="`+s.name+"."+string(s.format)+`"`)
+	s.c.Header("X-Accel-Buffering", "no")
+	s.c.Status(http.StatusOK)
+
+	writer, err := export.NewWriter(s.format, s.c.Writer, s.columns)
+	if err != nil {
+		return err
+	}
+	s.writer = writer
+	return nil
+}
+
+// write writes a row, flushing the response every exportFlushInterval rows
+func (s *exportStream) write(row []interface{}) error {
+	if s.writer == nil {
+		if err := s.start(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.writer.WriteRow(row); err != nil {
+		return err
+	}
+	s.rows++
+	if s.rows%exportFlushInterval == 0 {
+		if err := s.writer.Flush(); err != nil {
+			return err
+		}
+		s.c.Writer.Flush()
+	}
+	return nil
+}
+
+// failed returns true if the export failed before any row was sent, in which case an
+// error response can still be written
+func (s *exportStream) failed(err error) bool {
+	return err != nil && s.writer == nil
+}
+
+// close completes the export. An export failing after rows were sent aborts the
+// connection without completing the file or the chunked response, so that clients see a
+// failed transfer rather than a truncated file
+func (s *exportStream) close(err error) {
+	if err != nil {
+		slog.ErrorContext(s.c.Request.Context(), "Export failed", "export", s.name, "rows", s.rows, "error", err)
+		panic(http.ErrAbortHandler)
+	}
+
+	if s.writer == nil {
+		if err := s.start(); err != nil {
+			slog.ErrorContext(s.c.Request.Context(), "Export failed", "export", s.name, "rows", 0, "error", err)
+			return
+		}
+	}
+	if err := s.writer.Close(); err != nil {
+		slog.ErrorContext(s.c.Request.Context(), "Export failed", "export", s.name, "rows", s.rows, "error", err)
+		panic(http.ErrAbortHandler)
+	}
+	slog.InfoContext(s.c.Request.Context(), "Export completed", "export", s.name, "rows", s.rows)
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"strconv"
+
+	"data-server/internal/ports/input"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExtrinsicHandler handles extrinsic-related HTTP requests
+type ExtrinsicHandler struct {
+	extrinsicService input.ExtrinsicService
+}
+
+// NewExtrinsicHandler creates a new extrinsic handler
+func NewExtrinsicHandler(extrinsicService input.ExtrinsicService) *ExtrinsicHandler {
+	return &ExtrinsicHandler{
+		extrinsicService: extrinsicService,
+	}
+}
+
+// GetExtrinsicFailures handles GET /api/v1/extrinsics/failures
+func (h *ExtrinsicHandler) GetExtrinsicFailures(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	filter := input.ExtrinsicFailureFilter{
+		Validator: c.Query("validator"),
+	}
+
+	if bucketStr, ok := c.GetQuery("bucket"); ok {
+		bucket, err := strconv.Atoi(bucketStr)
+		if err != nil || bucket <= 0 {
+			response.BadRequest(c, "Invalid bucket size, expected a positive number of blocks")
+			return
+		}
+		filter.BucketSize = bucket
+	}
+
+	blockRange, ok := parseBlockRangeQuery(c)
+	if !ok {
+		return
+	}
+	filter.BlockRange = blockRange
+
+	report, err := h.extrinsicService.GetExtrinsicFailures(ctx, filter)
+	if err != nil {
+		respondError(c, "Failed to retrieve extrinsic failures", err)
+		return
+	}
+
+	response.Success(c, report)
+}
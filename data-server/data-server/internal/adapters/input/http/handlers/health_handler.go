@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+	"data-server/pkg/response"
+	"data-server/pkg/version"
+
+	"github.com/gin-gonic/gin"
+)
+
+// serviceName identifies the server in the health responses
+const serviceName = "blockchain-data-api"
+
+// HealthHandler handles liveness and readiness HTTP requests
+type HealthHandler struct {
+	healthService input.HealthService
+	build         version.Info
+}
+
+// NewHealthHandler creates a new health handler
+func NewHealthHandler(healthService input.HealthService) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+		build:         version.Get(),
+	}
+}
+
+// healthResponse represents the health of the server with its build
+type healthResponse struct {
+	Status  entities.HealthStatus `json:"status"`
+	Service string                `json:"service"`
+	version.Info
+	Checks    []entities.HealthCheck `json:"checks,omitempty"`
+	CheckedAt *time.Time             `json:"checked_at,omitempty"`
+}
+
+// Live handles GET /livez. The server is live as long as it serves requests, its
+// dependencies are not checked so that it is not restarted when they fail
+func (h *HealthHandler) Live(c *gin.Context) {
+	response.Success(c, healthResponse{
+		Status:  entities.HealthHealthy,
+		Service: serviceName,
+		Info:    h.build,
+	})
+}
+
+// Ready handles GET /readyz and GET /api/v1/health. The server is ready unless a
+// critical check fails or it is shutting down, in which case it responds with 503
+func (h *HealthHandler) Ready(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	report, err := h.healthService.CheckHealth(ctx)
+	if err != nil {
+		respondError(c, "Failed to check health", err)
+		return
+	}
+
+	body := healthResponse{
+		Status:    report.Status,
+		Service:   serviceName,
+		Info:      h.build,
+		Checks:    report.Checks,
+		CheckedAt: &report.CheckedAt,
+	}
+	if !report.Ready() {
+		response.Unavailable(c, body)
+		return
+	}
+	response.Success(c, body)
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IncidentHandler handles incident-related HTTP requests
+type IncidentHandler struct {
+	incidentService input.IncidentService
+}
+
+// NewIncidentHandler creates a new incident handler
+func NewIncidentHandler(incidentService input.IncidentService) *IncidentHandler {
+	return &IncidentHandler{
+		incidentService: incidentService,
+	}
+}
+
+// GetIncidents handles GET /api/v1/incidents
+func (h *IncidentHandler) GetIncidents(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	severity := c.Query("severity")
+	if severity != "" && !entities.IsValidIncidentSeverity(severity) {
+		response.BadRequest(c, "Invalid severity, expected one of low, medium, high, critical")
+		return
+	}
+
+	page, ok := parsePageQuery(c)
+	if !ok {
+		return
+	}
+
+	incidents, err := h.incidentService.GetIncidents(ctx, entities.IncidentSeverity(severity), page)
+	if err != nil {
+		respondError(c, "Failed to retrieve incidents", err)
+		return
+	}
+
+	respondPage(c, incidents)
+}
+
+// GetValidatorIncidents handles GET /api/v1/validators/:type/incidents
+func (h *IncidentHandler) GetValidatorIncidents(c *gin.Context) {
+	ctx := c.Request.Context()
+	validatorType := c.Param("type")
+
+	page, ok := parsePageQuery(c)
+	if !ok {
+		return
+	}
+
+	incidents, err := h.incidentService.GetValidatorIncidents(ctx, validatorType, page)
+	if err != nil {
+		respondError(c, "Failed to retrieve validator incidents", err)
+		return
+	}
+
+	respondPage(c, incidents)
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"data-server/internal/ports/input"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OffenceHandler handles offence-related HTTP requests
+type OffenceHandler struct {
+	offenceService input.OffenceService
+}
+
+// NewOffenceHandler creates a new offence handler
+func NewOffenceHandler(offenceService input.OffenceService) *OffenceHandler {
+	return &OffenceHandler{
+		offenceService: offenceService,
+	}
+}
+
+// GetOffences handles GET /api/v1/offences
+func (h *OffenceHandler) GetOffences(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	filter := input.OffenceFilter{
+		Kind:      c.Query("kind"),
+		Validator: c.Query("validator"),
+	}
+
+	blockRange, ok := parseBlockRangeQuery(c)
+	if !ok {
+		return
+	}
+	filter.BlockRange = blockRange
+
+	report, err := h.offenceService.GetOffenceReport(ctx, filter)
+	if err != nil {
+		respondError(c, "Failed to retrieve offences", err)
+		return
+	}
+
+	response.Success(c, report)
+}
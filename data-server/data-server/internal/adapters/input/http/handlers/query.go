@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/expression"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// parseBlockRangeQuery parses the optional start and end query parameters into a block
+// range. It writes a bad request response and returns false if they are invalid
+func parseBlockRangeQuery(c *gin.Context) (*valueobjects.BlockRange, bool) {
+	startBlockStr, hasStart := c.GetQuery("start")
+	endBlockStr, hasEnd := c.GetQuery("end")
+	if !hasStart && !hasEnd {
+		return nil, true
+	}
+
+	startBlock, endBlock := 0, math.MaxInt
+	var ok bool
+	if hasStart {
+		if startBlock, ok = parseBlock(c, "start", startBlockStr); !ok {
+			return nil, false
+		}
+	}
+	if hasEnd {
+		if endBlock, ok = parseBlock(c, "end", endBlockStr); !ok {
+			return nil, false
+		}
+	}
+
+	return newBlockRange(c, startBlock, endBlock)
+}
+
+// parseBlockRangeParams parses the start and end path parameters into a block range. It
+// writes a bad request response and returns false if they are invalid
+func parseBlockRangeParams(c *gin.Context) (*valueobjects.BlockRange, bool) {
+	startBlock, ok := parseBlock(c, "start", c.Param("start"))
+	if !ok {
+		return nil, false
+	}
+	endBlock, ok := parseBlock(c, "end", c.Param("end"))
+	if !ok {
+		return nil, false
+	}
+
+	return newBlockRange(c, startBlock, endBlock)
+}
+
+// parseBlock parses a block number. It writes a bad request response and returns false
+// if it is not an integer
+func parseBlock(c *gin.Context, name, value string) (int, bool) {
+	block, err := strconv.Atoi(value)
+	if err != nil {
+		respondError(c, "Invalid "+name+" block", fmt.Errorf("%w: %s block must be an integer", valueobjects.ErrInvalidBlockRange, name))
+		return 0, false
+	}
+	return block, true
+}
+
+// newBlockRange creates a block range. It writes a bad request response and returns
+// false if the range is negative or inverted
+func newBlockRange(c *gin.Context, startBlock, endBlock int) (*valueobjects.BlockRange, bool) {
+	blockRange, err := valueobjects.NewBlockRange(startBlock, endBlock)
+	if err != nil {
+		respondError(c, "Invalid block range", err)
+		return nil, false
+	}
+	return blockRange, true
+}
+
+// parsePageQuery parses the limit, cursor and order query parameters into a page request.
+// It writes a bad request response and returns false if they are invalid
+func parsePageQuery(c *gin.Context) (valueobjects.PageRequest, bool) {
+	limit := 0
+	if limitStr, ok := c.GetQuery("limit"); ok {
+		var err error
+		if limit, err = strconv.Atoi(limitStr); err != nil {
+			respondError(c, "Invalid limit", fmt.Errorf("%w: limit must be an integer", valueobjects.ErrInvalidPagination))
+			return valueobjects.PageRequest{}, false
+		}
+	}
+
+	page, err := valueobjects.NewPageRequest(limit, c.Query("cursor"), valueobjects.SortDirection(c.Query("order")))
+	if err != nil {
+		respondError(c, "Invalid pagination", err)
+		return valueobjects.PageRequest{}, false
+	}
+
+	return page, true
+}
+
+// respondPage sends a page of a list with its pagination metadata
+func respondPage[T any](c *gin.Context, page *input.Page[T]) {
+	if page == nil {
+		response.Success(c, nil)
+		return
+	}
+
+	response.SuccessWithPagination(c, page.Items, page.Info)
+}
+
+// parseEventQuery parses the event filter query parameters into an event query:
+// type, category and stash accept repeated or comma separated values, start and end
+// limit the block range, from and to the RFC 3339 time range, every where parameter
+// adds a field:operator:value payload predicate and filter compiles a CEL expression.
+// It writes a bad request response and returns false if they are invalid
+func parseEventQuery(c *gin.Context) (entities.EventQuery, bool) {
+	query := entities.EventQuery{
+		Types:      queryList(c, "type"),
+		Categories: queryList(c, "category"),
+		Stashes:    queryList(c, "stash"),
+	}
+
+	blockRange, ok := parseBlockRangeQuery(c)
+	if !ok {
+		return query, false
+	}
+	query.BlockRange = blockRange
+
+	for _, bound := range []struct {
+		name   string
+		target **time.Time
+	}{{"from", &query.From}, {"to", &query.To}} {
+		value, ok := c.GetQuery(bound.name)
+		if !ok {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			respondError(c, "Invalid "+bound.name+" time", fmt.Errorf("%w: %s must be an RFC 3339 time", entities.ErrInvalidEventQuery, bound.name))
+			return query, false
+		}
+		*bound.target = &parsed
+	}
+
+	for _, expression := range c.QueryArray("where") {
+		predicate, err := entities.ParsePayloadPredicate(expression)
+		if err != nil {
+			respondError(c, "Invalid payload predicate", err)
+			return query, false
+		}
+		query.Predicates = append(query.Predicates, predicate)
+	}
+
+	if source, ok := c.GetQuery("filter"); ok {
+		filter, err := expression.Compile(source)
+		if err != nil {
+			respondError(c, "Invalid filter expression", err)
+			return query, false
+		}
+		query.Filter = filter
+	}
+
+	if err := query.Validate(); err != nil {
+		respondError(c, "Invalid event query", err)
+		return query, false
+	}
+
+	return query, true
+}
+
+// queryList returns the values of a repeated or comma separated query parameter
+func queryList(c *gin.Context, key string) []string {
+	var values []string
+	for _, raw := range c.QueryArray(key) {
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
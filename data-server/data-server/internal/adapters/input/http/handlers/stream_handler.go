@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// StreamHeartbeatInterval is how often idle streams send a heartbeat ping
+const StreamHeartbeatInterval = 15 * time.Second
+
+// StreamHandler handles live event streaming requests over Server-Sent Events and WebSocket
+type StreamHandler struct {
+	streamService input.StreamService
+	upgrader      websocket.Upgrader
+}
+
+// NewStreamHandler creates a new stream handler. WebSocket upgrades are accepted from the
+// origins allowed to make cross-origin requests to the rest of the API: every origin if
+// allowAllOrigins is set, else the given origins
+func NewStreamHandler(streamService input.StreamService, allowAllOrigins bool, origins []string) *StreamHandler {
+	return &StreamHandler{
+		streamService: streamService,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return allowAllOrigins || originAllowed(r, origins)
+			},
+		},
+	}
+}
+
+// originAllowed returns true if the WebSocket upgrade request comes from one of the
+// allowed origins, from the origin of the API itself, or from a client which is not a
+// browser and sends no Origin header
+func originAllowed(r *http.Request, origins []string) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	for _, allowed := range origins {
+		if strings.EqualFold(origin, allowed) {
+			return true
+		}
+	}
+
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// streamMessage represents an event sent over a WebSocket stream
+type streamMessage struct {
+	ID string `json:"id"`
+	entities.ValidatorEvent
+}
+
+// StreamEvents handles GET /api/v1/stream/events
+func (h *StreamHandler) StreamEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	events, ok := h.subscribe(ctx, c)
+	if !ok {
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(StreamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(c.Writer, "id: %s\ndata: %s\n\n", event.ID(), data); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
+// StreamEventsWebSocket handles GET /api/v1/stream/events/ws
+func (h *StreamHandler) StreamEventsWebSocket(c *gin.Context) {
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	events, ok := h.subscribe(ctx, c)
+	if !ok {
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader already wrote an error response
+		return
+	}
+	defer conn.Close()
+
+	// Clients must answer pings, the read loop ends the stream when they stop
+	conn.SetReadDeadline(time.Now().Add(2 * StreamHeartbeatInterval))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * StreamHeartbeatInterval))
+	})
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(StreamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(StreamHeartbeatInterval)); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "stream interrupted, resume from the last event id"))
+				return
+			}
+			if err := conn.WriteJSON(streamMessage{ID: event.ID(), ValidatorEvent: event}); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// subscribe parses the event filters and the last received event id, taken from the
+// Last-Event-ID header or the last_event_id query parameter, and opens the stream. It
+// writes an error response and returns false if they are invalid
+func (h *StreamHandler) subscribe(ctx context.Context, c *gin.Context) (<-chan entities.ValidatorEvent, bool) {
+	query, ok := parseEventQuery(c)
+	if !ok {
+		return nil, false
+	}
+
+	var after *valueobjects.CursorKey
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	if lastEventID != "" {
+		position, err := valueobjects.ParseEventID(lastEventID)
+		if err != nil {
+			respondError(c, "Invalid last event id", err)
+			return nil, false
+		}
+		after = &position
+	}
+
+	events, err := h.streamService.StreamEvents(ctx, query, after)
+	if err != nil {
+		respondError(c, "Failed to open event stream", err)
+		return nil, false
+	}
+
+	return events, true
+}
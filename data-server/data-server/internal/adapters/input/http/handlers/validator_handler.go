@@ -1,10 +1,8 @@
 package handlers
 
 import (
-	"net/http"
-	"strconv"
-
 	"github.com/gin-gonic/gin"
+	"data-server/internal/domain/entities"
 	"data-server/internal/ports/input"
 	"data-server/pkg/response"
 )
@@ -25,13 +23,18 @@ func NewValidatorHandler(validatorService input.ValidatorService) *ValidatorHand
 func (h *ValidatorHandler) GetAllValidators(c *gin.Context) {
 	ctx := c.Request.Context()
 	
-	validators, err := h.validatorService.GetAllValidators(ctx)
+	page, ok := parsePageQuery(c)
+	if !ok {
+		return
+	}
+	
+	validators, err := h.validatorService.GetAllValidators(ctx, page)
 	if err != nil {
-		response.Error(c, http.StatusInternalServerError, "Failed to retrieve validators", err)
+		respondError(c, "Failed to retrieve validators", err)
 		return
 	}
 	
-	response.Success(c, validators)
+	respondPage(c, validators)
 }
 
 // GetValidatorByType handles GET /api/v1/validators/:type
@@ -41,7 +44,7 @@ func (h *ValidatorHandler) GetValidatorByType(c *gin.Context) {
 	
 	validator, err := h.validatorService.GetValidatorByType(ctx, validatorType)
 	if err != nil {
-		response.Error(c, http.StatusNotFound, "Validator not found", err)
+		respondError(c, "Failed to retrieve validator", err)
 		return
 	}
 	
@@ -50,60 +53,55 @@ func (h *ValidatorHandler) GetValidatorByType(c *gin.Context) {
 
 // GetValidatorEvents handles GET /api/v1/validators/:type/events
 func (h *ValidatorHandler) GetValidatorEvents(c *gin.Context) {
-	ctx := c.Request.Context()
-	validatorType := c.Param("type")
-	
-	events, err := h.validatorService.GetValidatorEvents(ctx, validatorType)
-	if err != nil {
-		response.Error(c, http.StatusNotFound, "Validator events not found", err)
-		return
-	}
-	
-	response.Success(c, events)
+	h.queryValidatorEvents(c, func(query *entities.EventQuery) {})
 }
 
 // GetValidatorEventsByType handles GET /api/v1/validators/:type/events/:eventType
 func (h *ValidatorHandler) GetValidatorEventsByType(c *gin.Context) {
-	ctx := c.Request.Context()
-	validatorType := c.Param("type")
 	eventType := c.Param("eventType")
 	
-	events, err := h.validatorService.GetValidatorEventsByType(ctx, validatorType, eventType)
-	if err != nil {
-		response.Error(c, http.StatusNotFound, "Events not found", err)
+	h.queryValidatorEvents(c, func(query *entities.EventQuery) {
+		query.Types = []string{eventType}
+	})
+}
+
+// GetValidatorEventsByBlockRange handles GET /api/v1/validators/:type/events/blocks/:start/:end
+func (h *ValidatorHandler) GetValidatorEventsByBlockRange(c *gin.Context) {
+	blockRange, ok := parseBlockRangeParams(c)
+	if !ok {
 		return
 	}
 	
-	response.Success(c, events)
+	h.queryValidatorEvents(c, func(query *entities.EventQuery) {
+		query.BlockRange = blockRange
+	})
 }
 
-// GetValidatorEventsByBlockRange handles GET /api/v1/validators/:type/events/blocks/:start/:end
-func (h *ValidatorHandler) GetValidatorEventsByBlockRange(c *gin.Context) {
+// queryValidatorEvents parses the event query and page from the request, lets the route
+// narrow the query with its path parameters and responds with the matching page of the
+// validator's events
+func (h *ValidatorHandler) queryValidatorEvents(c *gin.Context, narrow func(query *entities.EventQuery)) {
 	ctx := c.Request.Context()
 	validatorType := c.Param("type")
 	
-	startBlockStr := c.Param("start")
-	endBlockStr := c.Param("end")
-	
-	startBlock, err := strconv.Atoi(startBlockStr)
-	if err != nil {
-		response.Error(c, http.StatusBadRequest, "Invalid start block", err)
+	query, ok := parseEventQuery(c)
+	if !ok {
 		return
 	}
+	narrow(&query)
 	
-	endBlock, err := strconv.Atoi(endBlockStr)
-	if err != nil {
-		response.Error(c, http.StatusBadRequest, "Invalid end block", err)
+	page, ok := parsePageQuery(c)
+	if !ok {
 		return
 	}
 	
-	events, err := h.validatorService.GetValidatorEventsByBlockRange(ctx, validatorType, startBlock, endBlock)
+	events, err := h.validatorService.QueryValidatorEvents(ctx, validatorType, query, page)
 	if err != nil {
-		response.Error(c, http.StatusNotFound, "Events not found", err)
+		respondError(c, "Failed to retrieve validator events", err)
 		return
 	}
 	
-	response.Success(c, events)
+	respondPage(c, events)
 }
 
 // GetValidatorStats handles GET /api/v1/validators/:type/stats
@@ -113,9 +111,37 @@ func (h *ValidatorHandler) GetValidatorStats(c *gin.Context) {
 	
 	stats, err := h.validatorService.GetValidatorStats(ctx, validatorType)
 	if err != nil {
-		response.Error(c, http.StatusNotFound, "Validator stats not found", err)
+		respondError(c, "Failed to retrieve validator stats", err)
 		return
 	}
 	
 	response.Success(c, stats)
-} 
\ No newline at end of file
+}
+
+// GetValidatorPayouts handles GET /api/v1/validators/:type/payouts
+func (h *ValidatorHandler) GetValidatorPayouts(c *gin.Context) {
+	ctx := c.Request.Context()
+	validatorType := c.Param("type")
+
+	report, err := h.validatorService.GetValidatorPayouts(ctx, validatorType)
+	if err != nil {
+		respondError(c, "Failed to retrieve validator payouts", err)
+		return
+	}
+
+	response.Success(c, report)
+}
+
+// GetValidatorStake handles GET /api/v1/validators/:type/stake
+func (h *ValidatorHandler) GetValidatorStake(c *gin.Context) {
+	ctx := c.Request.Context()
+	validatorType := c.Param("type")
+
+	ledger, err := h.validatorService.GetValidatorStake(ctx, validatorType)
+	if err != nil {
+		respondError(c, "Failed to retrieve validator stake", err)
+		return
+	}
+
+	response.Success(c, ledger)
+}
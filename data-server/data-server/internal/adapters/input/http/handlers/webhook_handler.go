@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"net/http"
+
+	"data-server/internal/ports/input"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookHandler handles webhook subscription HTTP requests
+type WebhookHandler struct {
+	webhookService input.WebhookService
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(webhookService input.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// CreateWebhook handles POST /api/v1/webhooks
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var request input.WebhookRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid webhook request body", err)
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(ctx, request)
+	if err != nil {
+		respondError(c, "Failed to create webhook", err)
+		return
+	}
+
+	response.Created(c, webhook)
+}
+
+// GetWebhooks handles GET /api/v1/webhooks
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	page, ok := parsePageQuery(c)
+	if !ok {
+		return
+	}
+
+	webhooks, err := h.webhookService.GetWebhooks(ctx, page)
+	if err != nil {
+		respondError(c, "Failed to retrieve webhooks", err)
+		return
+	}
+
+	respondPage(c, webhooks)
+}
+
+// GetWebhook handles GET /api/v1/webhooks/:id
+func (h *WebhookHandler) GetWebhook(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	webhook, err := h.webhookService.GetWebhook(ctx, id)
+	if err != nil {
+		respondError(c, "Failed to retrieve webhook", err)
+		return
+	}
+
+	response.Success(c, webhook)
+}
+
+// UpdateWebhook handles PUT /api/v1/webhooks/:id
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	var request input.WebhookRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid webhook request body", err)
+		return
+	}
+
+	webhook, err := h.webhookService.UpdateWebhook(ctx, id, request)
+	if err != nil {
+		respondError(c, "Failed to update webhook", err)
+		return
+	}
+
+	response.Success(c, webhook)
+}
+
+// DeleteWebhook handles DELETE /api/v1/webhooks/:id
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if err := h.webhookService.DeleteWebhook(ctx, id); err != nil {
+		respondError(c, "Failed to delete webhook", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetWebhookDeliveries handles GET /api/v1/webhooks/:id/deliveries
+func (h *WebhookHandler) GetWebhookDeliveries(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	page, ok := parsePageQuery(c)
+	if !ok {
+		return
+	}
+
+	deliveries, err := h.webhookService.GetWebhookDeliveries(ctx, id, page)
+	if err != nil {
+		respondError(c, "Failed to retrieve webhook deliveries", err)
+		return
+	}
+
+	respondPage(c, deliveries)
+}
+
+// PingWebhook handles POST /api/v1/webhooks/:id/ping
+func (h *WebhookHandler) PingWebhook(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	delivery, err := h.webhookService.PingWebhook(ctx, id)
+	if err != nil {
+		respondError(c, "Failed to ping webhook", err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response.APIResponse{
+		Success: true,
+		Data:    delivery,
+	})
+}
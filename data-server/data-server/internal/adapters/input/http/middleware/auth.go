@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"data-server/internal/domain/domainerr"
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Auth authenticates requests with API keys. The key is taken from a bearer token, the
+// X-API-Key header or, on the stream routes only, where browser clients cannot set
+// headers, the api_key query parameter
+type Auth struct {
+	apiKeyService   input.APIKeyService
+	rateLimit       *RateLimit
+	anonymousScopes []entities.APIKeyScope
+}
+
+// NewAuth creates a new authentication middleware, requests without a key are granted
+// the given scopes. Requests failing authentication are charged to the given rate limit
+func NewAuth(apiKeyService input.APIKeyService, rateLimit *RateLimit, anonymousScopes ...entities.APIKeyScope) *Auth {
+	return &Auth{
+		apiKeyService:   apiKeyService,
+		rateLimit:       rateLimit,
+		anonymousScopes: anonymousScopes,
+	}
+}
+
+// Authenticate returns a middleware attaching the principal of the request to its
+// context, for the handlers and use cases to check. Requests with an unknown or expired
+// key are rejected, after being charged to the bucket of their IP address like requests
+// without a key, so that guessing keys is rate limited too
+func (m *Auth) Authenticate() gin.HandlerFunc {
+	return m.authenticate(false)
+}
+
+// AuthenticateStream returns a middleware authenticating the requests of stream routes
+// like Authenticate, also reading the key from the api_key query parameter. Query
+// parameters end up in proxy logs and browser histories, so no other route reads them
+func (m *Auth) AuthenticateStream() gin.HandlerFunc {
+	return m.authenticate(true)
+}
+
+// authenticate returns a middleware attaching the principal of the request to its
+// context, reading the key from the query parameters too when query is true
+func (m *Auth) authenticate(query bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		principal := entities.AnonymousPrincipal(m.anonymousScopes...)
+		if secret := apiKeyCredentials(c.Request, query); secret != "" {
+			authenticated, err := m.apiKeyService.Authenticate(ctx, secret)
+			if err != nil {
+				if m.rateLimit.take(c, m.rateLimit.cost(c)) {
+					respondAuthError(c, err)
+				}
+				return
+			}
+			principal = authenticated
+		}
+
+		c.Request = c.Request.WithContext(entities.WithPrincipal(ctx, principal))
+		c.Next()
+	}
+}
+
+// RequireScope returns a middleware rejecting requests whose principal lacks the scope
+func RequireScope(scope entities.APIKeyScope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := entities.RequireScope(c.Request.Context(), scope); err != nil {
+			respondAuthError(c, err)
+			return
+		}
+		c.Next()
+	}
+}
+
+// apiKeyCredentials returns the API key of a request, or "" if it has none. The api_key
+// query parameter is only read when query is true
+func apiKeyCredentials(r *http.Request, query bool) string {
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		scheme, token, ok := strings.Cut(authorization, " ")
+		if ok && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		return key
+	}
+	if query {
+		return r.URL.Query().Get("api_key")
+	}
+	return ""
+}
+
+// respondAuthError sends the problem details of an authentication error
+func respondAuthError(c *gin.Context, err error) {
+	switch domainerr.KindOf(err) {
+	case domainerr.Unauthenticated:
+		c.Header("WWW-Authenticate", `Bearer realm="data-server"`)
+		response.Error(c, http.StatusUnauthorized, "Authentication required", err)
+	case domainerr.Forbidden:
+		response.Error(c, http.StatusForbidden, "Insufficient scope", err)
+	default:
+		response.Error(c, http.StatusInternalServerError, "Failed to authenticate", err)
+	}
+}
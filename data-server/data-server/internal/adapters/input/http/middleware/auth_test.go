@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+	"data-server/pkg/ratelimit"
+
+	"github.com/gin-gonic/gin"
+)
+
+// stubKeys authenticates the secrets of its principals, other secrets are unknown
+type stubKeys struct {
+	input.APIKeyService
+	principals map[string]*entities.Principal
+}
+
+func (s stubKeys) Authenticate(ctx context.Context, secret string) (*entities.Principal, error) {
+	principal, ok := s.principals[secret]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown API key", entities.ErrUnauthenticated)
+	}
+	return principal, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	keys := stubKeys{principals: map[string]*entities.Principal{
+		"reader": {KeyID: "key-1", Name: "reader", Scopes: []entities.APIKeyScope{entities.ScopeRead}},
+		"admin":  {KeyID: "key-2", Name: "admin", Scopes: []entities.APIKeyScope{entities.ScopeAdmin}},
+	}}
+	rateLimit := NewRateLimit(ratelimit.NewLimiter(), ratelimit.Policy{}, ratelimit.Costs{})
+	auth := NewAuth(keys, rateLimit)
+
+	r := gin.New()
+	principal := func(c *gin.Context) {
+		p, _ := entities.PrincipalFromContext(c.Request.Context())
+		c.String(http.StatusOK, p.Name)
+	}
+	r.GET("/read", auth.Authenticate(), RequireScope(entities.ScopeRead), principal)
+	r.GET("/admin", auth.Authenticate(), RequireScope(entities.ScopeAdmin), principal)
+	r.GET("/stream", auth.AuthenticateStream(), RequireScope(entities.ScopeRead), principal)
+
+	tests := []struct {
+		name      string
+		target    string
+		header    map[string]string
+		status    int
+		principal string
+	}{
+		{name: "bearer token", target: "/read", header: map[string]string{"Authorization": "Bearer reader"}, status: http.StatusOK, principal: "reader"},
+		{name: "lower case bearer scheme", target: "/read", header: map[string]string{"Authorization": "bearer reader"}, status: http.StatusOK, principal: "reader"},
+		{name: "X-API-Key header", target: "/read", header: map[string]string{"X-API-Key": "reader"}, status: http.StatusOK, principal: "reader"},
+		{name: "the bearer token wins over the header", target: "/read", header: map[string]string{"Authorization": "Bearer admin", "X-API-Key": "reader"}, status: http.StatusOK, principal: "admin"},
+		{name: "unknown or expired key", target: "/read", header: map[string]string{"X-API-Key": "expired"}, status: http.StatusUnauthorized},
+		{name: "no key without anonymous scopes", target: "/read", status: http.StatusUnauthorized},
+		{name: "insufficient scope", target: "/admin", header: map[string]string{"X-API-Key": "reader"}, status: http.StatusForbidden},
+		{name: "the admin scope grants every scope", target: "/read", header: map[string]string{"X-API-Key": "admin"}, status: http.StatusOK, principal: "admin"},
+		{name: "query parameter on a stream route", target: "/stream?api_key=reader", status: http.StatusOK, principal: "reader"},
+		{name: "query parameter ignored on other routes", target: "/read?api_key=reader", status: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			for name, value := range tt.header {
+				req.Header.Set(name, value)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if tt.status == http.StatusOK && w.Body.String() != tt.principal {
+				t.Errorf("principal = %q, want %q", w.Body.String(), tt.principal)
+			}
+			if tt.status == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+				t.Error("WWW-Authenticate header is missing")
+			}
+		})
+	}
+}
+
+func TestAuthChargesFailedAuthenticationToTheClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	keys := stubKeys{principals: map[string]*entities.Principal{
+		"good": {KeyID: "key-1", Name: "reader", Scopes: []entities.APIKeyScope{entities.ScopeRead}},
+	}}
+	policy := ratelimit.Policy{
+		IP:  ratelimit.Rate{PerMinute: 1, Burst: 1},
+		Key: ratelimit.Rate{PerMinute: 100, Burst: 100},
+	}
+	rateLimit := NewRateLimit(ratelimit.NewLimiter(), policy, ratelimit.Costs{})
+	auth := NewAuth(keys, rateLimit, entities.ScopeRead)
+
+	r := gin.New()
+	r.GET("/", auth.Authenticate(), rateLimit.Handler(), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	requests := []struct {
+		key    string
+		status int
+	}{
+		{key: "guess-1", status: http.StatusUnauthorized},
+		{key: "guess-2", status: http.StatusTooManyRequests},
+		{key: "", status: http.StatusTooManyRequests},
+		{key: "good", status: http.StatusOK},
+	}
+	for i, req := range requests {
+		httpReq := httptest.NewRequest(http.MethodGet, "/", nil)
+		httpReq.RemoteAddr = "203.0.113.7:1234"
+		if req.key != "" {
+			httpReq.Header.Set("X-API-Key", req.key)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httpReq)
+
+		if w.Code != req.status {
+			t.Errorf("request %d status = %d, want %d", i, w.Code, req.status)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package middleware
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"data-server/internal/ports/input"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Cache validates responses derived from the validator data. Their entity tag and
+// modification date are those of the data version, so a client holding a response
+// receives 304 Not Modified until new events arrive. When API keys are required the
+// responses are private to the client, so shared caches do not serve them to clients
+// without a key
+type Cache struct {
+	validatorService input.ValidatorService
+	private          bool
+}
+
+// NewCache creates a new cache middleware, marking the responses private when they are
+// only served to authenticated clients
+func NewCache(validatorService input.ValidatorService, private bool) *Cache {
+	return &Cache{
+		validatorService: validatorService,
+		private:          private,
+	}
+}
+
+// Handler returns a middleware setting the ETag, Last-Modified and given Cache-Control
+// headers, and answering 304 Not Modified to conditional requests when the data version
+// has not changed. The handler still runs first, so invalid requests and missing
+// resources get their error response rather than 304
+func (m *Cache) Handler(cacheControl string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		version, err := m.validatorService.GetDataVersion(c.Request.Context())
+		if err != nil {
+			// The response is still served, it just cannot be validated
+			slog.WarnContext(c.Request.Context(), "Failed to get data version", "error", err)
+			c.Next()
+			return
+		}
+
+		// The tag is weak as compressed and uncompressed responses share it
+		etag := `W/"` + version.Tag() + `"`
+		lastModified := version.LastModified()
+
+		header := c.Writer.Header()
+		header.Set("ETag", etag)
+		header.Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		if m.private {
+			header.Set("Cache-Control", "private, "+cacheControl)
+			header.Add("Vary", "Authorization, X-API-Key")
+		} else {
+			header.Set("Cache-Control", cacheControl)
+		}
+
+		if notModified(c.Request, etag, lastModified) {
+			writer := &conditionalWriter{ResponseWriter: c.Writer, status: http.StatusOK, size: noWritten}
+			c.Writer = writer
+			c.Next()
+			c.Writer = writer.ResponseWriter
+			writer.finish()
+			return
+		}
+
+		c.Writer = &cacheWriter{ResponseWriter: c.Writer}
+		c.Next()
+	}
+}
+
+// NoStore returns a middleware preventing clients and proxies from storing responses
+func NoStore() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "no-store")
+		c.Next()
+	}
+}
+
+// notModified returns true if the conditional headers of the request match the current
+// version. If-None-Match takes precedence over If-Modified-Since, as in RFC 9110
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		return etagMatches(match, etag)
+	}
+
+	if since := r.Header.Get("If-Modified-Since"); since != "" {
+		t, err := http.ParseTime(since)
+		return err == nil && !lastModified.After(t)
+	}
+
+	return false
+}
+
+// etagMatches returns true if a tag of an If-None-Match header matches the entity tag,
+// using the weak comparison
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheWriter drops the validators from error responses, which do not represent the
+// data version and must not be cached
+type cacheWriter struct {
+	gin.ResponseWriter
+}
+
+// WriteHeader sets the status code of the response
+func (w *cacheWriter) WriteHeader(code int) {
+	if !succeeded(code) {
+		dropValidators(w.Header())
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// noWritten is the size of a response whose headers have not been written
+const noWritten = -1
+
+// conditionalWriter holds back the response to a conditional request matching the data
+// version. A successful response is replaced by 304 Not Modified, an error response is
+// sent as the handler wrote it
+type conditionalWriter struct {
+	gin.ResponseWriter
+	status int
+	size   int
+	body   bytes.Buffer
+}
+
+// WriteHeader sets the status code of the response
+func (w *conditionalWriter) WriteHeader(code int) {
+	if code > 0 && !w.Written() {
+		w.status = code
+	}
+}
+
+// WriteHeaderNow marks the headers as written
+func (w *conditionalWriter) WriteHeaderNow() {
+	if !w.Written() {
+		w.size = 0
+	}
+}
+
+// Write holds back a chunk of the response body
+func (w *conditionalWriter) Write(data []byte) (int, error) {
+	w.WriteHeaderNow()
+	n, err := w.body.Write(data)
+	w.size += n
+	return n, err
+}
+
+// WriteString holds back a string of the response body
+func (w *conditionalWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Status returns the status code written by the handler
+func (w *conditionalWriter) Status() int {
+	return w.status
+}
+
+// Size returns the size of the body written by the handler
+func (w *conditionalWriter) Size() int {
+	return w.size
+}
+
+// Written returns true if the handler wrote the headers
+func (w *conditionalWriter) Written() bool {
+	return w.size != noWritten
+}
+
+// Flush does nothing, the response is only sent once the handler returns
+func (w *conditionalWriter) Flush() {}
+
+// finish sends 304 Not Modified in place of a successful response, or the error response
+// written by the handler
+func (w *conditionalWriter) finish() {
+	header := w.Header()
+	if succeeded(w.status) {
+		header.Del("Content-Type")
+		header.Del("Content-Length")
+		w.ResponseWriter.WriteHeader(http.StatusNotModified)
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+
+	dropValidators(header)
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.WriteHeaderNow()
+	if w.body.Len() > 0 {
+		w.ResponseWriter.Write(w.body.Bytes())
+	}
+}
+
+// succeeded returns true if the status code is a success
+func succeeded(code int) bool {
+	return code >= 200 && code < 300
+}
+
+// dropValidators removes the validators from the headers of a response which does not
+// represent the data version and must not be cached
+func dropValidators(header http.Header) {
+	header.Del("ETag")
+	header.Del("Last-Modified")
+	header.Set("Cache-Control", "no-store")
+}
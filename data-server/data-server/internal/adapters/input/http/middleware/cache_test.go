@@ -0,0 +1,169 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+
+	"github.com/gin-gonic/gin"
+)
+
+// stubVersions returns the data version, or err when it is set
+type stubVersions struct {
+	input.ValidatorService
+	version entities.DataVersion
+	err     error
+}
+
+func (s stubVersions) GetDataVersion(ctx context.Context) (*entities.DataVersion, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &s.version, nil
+}
+
+func TestCacheHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	version := entities.DataVersion{Revision: 7, Block: 114011, UpdatedAt: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)}
+	etag := `W/"` + version.Tag() + `"`
+	lastModified := version.LastModified().Format(http.TimeFormat)
+	before := version.LastModified().Add(-time.Hour).Format(http.TimeFormat)
+
+	tests := []struct {
+		name         string
+		err          error
+		private      bool
+		header       map[string]string
+		status       int
+		responded    int
+		body         string
+		etag         string
+		cacheControl string
+	}{
+		{
+			name:         "unconditional requests get the validators",
+			status:       http.StatusOK,
+			responded:    http.StatusOK,
+			body:         `{"ok":true}`,
+			etag:         etag,
+			cacheControl: "max-age=5",
+		},
+		{
+			name:         "a matching entity tag gets 304",
+			header:       map[string]string{"If-None-Match": `"other", ` + etag},
+			status:       http.StatusOK,
+			responded:    http.StatusNotModified,
+			etag:         etag,
+			cacheControl: "max-age=5",
+		},
+		{
+			name:         "the strong form of the tag matches too",
+			header:       map[string]string{"If-None-Match": `"` + version.Tag() + `"`},
+			status:       http.StatusOK,
+			responded:    http.StatusNotModified,
+			etag:         etag,
+			cacheControl: "max-age=5",
+		},
+		{
+			name:         "an unchanged modification date gets 304",
+			header:       map[string]string{"If-Modified-Since": lastModified},
+			status:       http.StatusOK,
+			responded:    http.StatusNotModified,
+			etag:         etag,
+			cacheControl: "max-age=5",
+		},
+		{
+			name:         "an older modification date gets the response",
+			header:       map[string]string{"If-Modified-Since": before},
+			status:       http.StatusOK,
+			responded:    http.StatusOK,
+			body:         `{"ok":true}`,
+			etag:         etag,
+			cacheControl: "max-age=5",
+		},
+		{
+			name:         "If-None-Match takes precedence over If-Modified-Since",
+			header:       map[string]string{"If-None-Match": `"other"`, "If-Modified-Since": lastModified},
+			status:       http.StatusOK,
+			responded:    http.StatusOK,
+			body:         `{"ok":true}`,
+			etag:         etag,
+			cacheControl: "max-age=5",
+		},
+		{
+			name:         "errors of conditional requests are passed through without validators",
+			header:       map[string]string{"If-None-Match": etag},
+			status:       http.StatusNotFound,
+			responded:    http.StatusNotFound,
+			body:         `{"ok":false}`,
+			cacheControl: "no-store",
+		},
+		{
+			name:         "errors of unconditional requests are sent without validators",
+			status:       http.StatusBadRequest,
+			responded:    http.StatusBadRequest,
+			body:         `{"ok":false}`,
+			cacheControl: "no-store",
+		},
+		{
+			name:         "responses are private when keys are required",
+			private:      true,
+			status:       http.StatusOK,
+			responded:    http.StatusOK,
+			body:         `{"ok":true}`,
+			etag:         etag,
+			cacheControl: "private, max-age=5",
+		},
+		{
+			name:      "responses are served unvalidated without a data version",
+			err:       errors.New("storage unavailable"),
+			header:    map[string]string{"If-None-Match": etag},
+			status:    http.StatusOK,
+			responded: http.StatusOK,
+			body:      `{"ok":true}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewCache(stubVersions{version: version, err: tt.err}, tt.private)
+			r := gin.New()
+			r.GET("/", cache.Handler("max-age=5"), func(c *gin.Context) {
+				c.JSON(tt.status, gin.H{"ok": succeeded(tt.status)})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for name, value := range tt.header {
+				req.Header.Set(name, value)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.responded {
+				t.Errorf("status = %d, want %d", w.Code, tt.responded)
+			}
+			if w.Body.String() != tt.body {
+				t.Errorf("body = %q, want %q", w.Body.String(), tt.body)
+			}
+			if got := w.Header().Get("ETag"); got != tt.etag {
+				t.Errorf("ETag = %q, want %q", got, tt.etag)
+			}
+			if got := w.Header().Get("Cache-Control"); got != tt.cacheControl {
+				t.Errorf("Cache-Control = %q, want %q", got, tt.cacheControl)
+			}
+			if tt.private && w.Header().Get("Vary") != "Authorization, X-API-Key" {
+				t.Errorf("Vary = %q, want the credential headers", w.Header().Get("Vary"))
+			}
+			if w.Code == http.StatusNotModified && w.Header().Get("Content-Type") != "" {
+				t.Errorf("304 response has Content-Type %q", w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// compressMinSize is the smallest response body worth compressing, in bytes
+	compressMinSize = 1024
+
+	// brotliLevel trades some compression for the speed needed on every request
+	brotliLevel = 4
+)
+
+// encoder compresses a response body
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// encoderPools reuse the encoders by content encoding, as they allocate large windows
+var encoderPools = map[string]*sync.Pool{
+	"br": {New: func() interface{} {
+		return brotli.NewWriterLevel(nil, brotliLevel)
+	}},
+	"gzip": {New: func() interface{} {
+		return gzip.NewWriter(nil)
+	}},
+}
+
+// Compress returns a middleware compressing responses of at least compressMinSize bytes
+// with brotli or gzip, whichever the Accept-Encoding header of the client prefers
+func Compress() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+
+		writer := &compressWriter{ResponseWriter: c.Writer, encoding: encoding}
+		c.Writer = writer
+		defer writer.close()
+		c.Next()
+	}
+}
+
+// negotiateEncoding returns the supported content encoding with the highest quality in
+// an Accept-Encoding header, preferring brotli on ties, or "" if none is acceptable
+func negotiateEncoding(accept string) string {
+	qualities := map[string]float64{}
+	for _, part := range strings.Split(accept, ",") {
+		fields := strings.Split(part, ";")
+		coding := strings.ToLower(strings.TrimSpace(fields[0]))
+		if coding == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if q, err := strconv.ParseFloat(value, 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		qualities[coding] = quality
+	}
+
+	best, bestQuality := "", 0.0
+	for _, coding := range []string{"br", "gzip"} {
+		quality, ok := qualities[coding]
+		if !ok {
+			quality = qualities["*"]
+		}
+		if quality > bestQuality {
+			best, bestQuality = coding, quality
+		}
+	}
+	return best
+}
+
+// compressWriter buffers the start of a response body and compresses the body once it
+// reaches compressMinSize. Smaller bodies and unsuccessful responses are sent as is
+type compressWriter struct {
+	gin.ResponseWriter
+	encoding string
+	buffer   []byte
+	encoder  encoder
+	started  bool
+}
+
+// Write writes to the response body
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if !w.started {
+		w.buffer = append(w.buffer, data...)
+		if len(w.buffer) < compressMinSize {
+			return len(data), nil
+		}
+		return len(data), w.start(true)
+	}
+
+	if w.encoder != nil {
+		return w.encoder.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString writes a string to the response body
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow defers sending the headers until it is known whether the body is
+// compressed
+func (w *compressWriter) WriteHeaderNow() {}
+
+// Flush sends the body written so far, compressing it if the response allows
+func (w *compressWriter) Flush() {
+	if !w.started {
+		if err := w.start(true); err != nil {
+			return
+		}
+	}
+	if w.encoder != nil {
+		if err := w.encoder.Flush(); err != nil {
+			return
+		}
+	}
+	w.ResponseWriter.Flush()
+}
+
+// start sends the headers and the buffered body, setting up the encoder if the response
+// is to be compressed
+func (w *compressWriter) start(compress bool) error {
+	w.started = true
+
+	header := w.Header()
+	status := w.Status()
+	if compress && status >= 200 && status < 300 && status != http.StatusNoContent && header.Get("Content-Encoding") == "" {
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+		w.encoder = encoderPools[w.encoding].Get().(encoder)
+		w.encoder.Reset(w.ResponseWriter)
+	}
+
+	w.ResponseWriter.WriteHeaderNow()
+	buffer := w.buffer
+	w.buffer = nil
+	if len(buffer) == 0 {
+		return nil
+	}
+	if w.encoder != nil {
+		_, err := w.encoder.Write(buffer)
+		return err
+	}
+	_, err := w.ResponseWriter.Write(buffer)
+	return err
+}
+
+// close completes the response, sending a body too small to compress as is
+func (w *compressWriter) close() {
+	if !w.started {
+		w.start(false)
+		return
+	}
+	if w.encoder != nil {
+		w.encoder.Close()
+		w.encoder.Reset(io.Discard)
+		encoderPools[w.encoding].Put(w.encoder)
+		w.encoder = nil
+	}
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCompress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	large := strings.Repeat("staking.Rewarded,", compressMinSize/10)
+	small := "staking.Rewarded"
+
+	tests := []struct {
+		name     string
+		method   string
+		accept   string
+		status   int
+		body     string
+		encoding string
+	}{
+		{name: "large bodies are compressed with brotli", method: http.MethodGet, accept: "gzip, br", status: http.StatusOK, body: large, encoding: "br"},
+		{name: "the preferred encoding wins", method: http.MethodGet, accept: "br;q=0.5, gzip", status: http.StatusOK, body: large, encoding: "gzip"},
+		{name: "a wildcard accepts brotli", method: http.MethodGet, accept: "*", status: http.StatusOK, body: large, encoding: "br"},
+		{name: "bodies below the threshold are sent as is", method: http.MethodGet, accept: "br, gzip", status: http.StatusOK, body: small},
+		{name: "bodies are sent as is without a supported encoding", method: http.MethodGet, accept: "deflate", status: http.StatusOK, body: large},
+		{name: "refused encodings are not used", method: http.MethodGet, accept: "br;q=0, gzip;q=0", status: http.StatusOK, body: large},
+		{name: "error responses are sent as is", method: http.MethodGet, accept: "br, gzip", status: http.StatusInternalServerError, body: large},
+		{name: "HEAD responses are sent as is", method: http.MethodHead, accept: "br, gzip", status: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			handler := func(c *gin.Context) {
+				c.Data(tt.status, "text/csv", []byte(tt.body))
+			}
+			r.GET("/", Compress(), handler)
+			r.HEAD("/", Compress(), handler)
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			req.Header.Set("Accept-Encoding", tt.accept)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Errorf("status = %d, want %d", w.Code, tt.status)
+			}
+			if got := w.Header().Get("Content-Encoding"); got != tt.encoding {
+				t.Errorf("Content-Encoding = %q, want %q", got, tt.encoding)
+			}
+			if w.Header().Get("Vary") != "Accept-Encoding" {
+				t.Errorf("Vary = %q, want Accept-Encoding", w.Header().Get("Vary"))
+			}
+
+			body, err := decodeBody(w.Header().Get("Content-Encoding"), w.Body.Bytes())
+			if err != nil {
+				t.Fatalf("decodeBody: %v", err)
+			}
+			if string(body) != tt.body {
+				t.Errorf("decoded body has %d bytes, want %d", len(body), len(tt.body))
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/pkg/logger"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader carries the identifier of a request, given by the client or the server
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID returns a middleware identifying every request by the X-Request-ID header
+// of the client, when valid, or else by a random identifier sent back in the response
+// header. The identifier is attached to the request context with the route, validator
+// and block range of the request, so every record logged while serving it carries them
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !logger.ValidRequestID(id) {
+			id = logger.NewRequestID()
+		}
+		c.Header(RequestIDHeader, id)
+
+		ctx := logger.WithRequestID(c.Request.Context(), id)
+		ctx = logger.With(ctx, requestFields(c)...)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// AccessLog returns a middleware logging every request once served, at the error level
+// for server errors, the warn level for client errors and the info level otherwise
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Int("bytes", c.Writer.Size()),
+			slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("client_ip", c.ClientIP()),
+		}
+		if principal, ok := entities.PrincipalFromContext(c.Request.Context()); ok && !principal.Anonymous() {
+			attrs = append(attrs, slog.String("key_id", principal.KeyID))
+		}
+		slog.LogAttrs(c.Request.Context(), level, "Request served", attrs...)
+	}
+}
+
+// Recovery returns a middleware turning panics of the handlers into internal server
+// errors, logged with their stack, except the http.ErrAbortHandler panics aborting a
+// response
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, recovered interface{}) {
+		// Handlers abort a response already under way with http.ErrAbortHandler, which the
+		// server answers by cutting the connection
+		if recovered == http.ErrAbortHandler {
+			panic(recovered)
+		}
+		slog.ErrorContext(c.Request.Context(), "Panic while serving request", "panic", fmt.Sprint(recovered), "stack", string(debug.Stack()))
+		response.WriteProblem(c, response.Problem{
+			Title:  "Internal server error",
+			Status: http.StatusInternalServerError,
+			Code:   response.StatusCode(http.StatusInternalServerError),
+		})
+	})
+}
+
+// requestFields returns the log fields describing a request: its route, and its
+// validator and block range when it has them
+func requestFields(c *gin.Context) []slog.Attr {
+	route := c.FullPath()
+	if route == "" {
+		route = unmatchedRoute
+	}
+
+	attrs := []slog.Attr{slog.String("route", route)}
+	if validatorType := c.Param("type"); validatorType != "" {
+		attrs = append(attrs, slog.String("validator", validatorType))
+	}
+	if stash := c.Param("stash"); stash != "" {
+		attrs = append(attrs, slog.String("validator", stash))
+	}
+	if startBlock, endBlock, ok := blockRange(c); ok {
+		attrs = append(attrs, slog.Int("start_block", startBlock), slog.Int("end_block", endBlock))
+	}
+	return attrs
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"time"
+
+	"data-server/internal/ports/output"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute labels the requests matching no route, so that unknown paths do not
+// create a series each
+const unmatchedRoute = "unmatched"
+
+// Metrics returns a middleware recording the method, route pattern, status code and
+// duration of every request
+func Metrics(metrics output.Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		metrics.ObserveHTTPRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,208 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+
+	"data-server/internal/adapters/input/http/openapi"
+	"data-server/pkg/response"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+// validatedBodyMaxSize is the largest response body validated against the spec, in
+// bytes. Larger bodies are sent without being validated
+const validatedBodyMaxSize = 16 << 20
+
+// ValidateRequests returns a middleware rejecting the requests whose path, query or header
+// parameters do not match the operation of their route in the OpenAPI spec
+func ValidateRequests(spec *openapi.Spec) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := spec.ValidateRequest(c); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request parameter", err)
+			return
+		}
+		c.Next()
+	}
+}
+
+// ValidateResponses returns a middleware validating every response against the operation
+// of its route in the OpenAPI spec. JSON bodies are held back until the handler returns,
+// and a response which drifted from the spec is logged and replaced by a 500 problem
+// naming the schema error, so it is meant for development and tests
+func ValidateResponses(spec *openapi.Spec) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		writer := &validationWriter{ResponseWriter: c.Writer, status: http.StatusOK, size: noWritten}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		if writer.passed {
+			return
+		}
+		body, err := decodeBody(writer.Header().Get("Content-Encoding"), writer.body.Bytes())
+		if err == nil {
+			err = spec.ValidateResponse(c, writer.status, writer.Header(), body)
+		}
+		if err == nil {
+			writer.send()
+			return
+		}
+
+		slog.ErrorContext(c.Request.Context(), "Response does not match the OpenAPI spec",
+			"method", c.Request.Method, "route", c.FullPath(), "status", writer.status, "error", err)
+
+		header := writer.Header()
+		header.Del("Content-Encoding")
+		header.Del("Content-Length")
+		dropValidators(header)
+		response.WriteProblem(c, response.Problem{
+			Title:  "Response does not match the OpenAPI spec",
+			Status: http.StatusInternalServerError,
+			Detail: err.Error(),
+			Code:   "invalid_response",
+		})
+	}
+}
+
+// validationWriter holds back the JSON responses up to validatedBodyMaxSize until they are
+// validated. The bodies of other media types such as exports and streams, larger bodies
+// and the connections hijacked by WebSocket upgrades are passed through unvalidated
+type validationWriter struct {
+	gin.ResponseWriter
+	status int
+	size   int
+	body   bytes.Buffer
+	passed bool
+}
+
+// WriteHeader sets the status code of the response
+func (w *validationWriter) WriteHeader(code int) {
+	if w.passed {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if code > 0 && !w.Written() {
+		w.status = code
+	}
+}
+
+// WriteHeaderNow marks the headers as written
+func (w *validationWriter) WriteHeaderNow() {
+	if w.passed {
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	if !w.Written() {
+		w.size = 0
+	}
+}
+
+// Write holds back a chunk of a JSON body, or writes it once the body is passed through
+func (w *validationWriter) Write(data []byte) (int, error) {
+	if !w.passed && (!openapi.IsJSON(w.Header().Get("Content-Type")) || w.body.Len()+len(data) > validatedBodyMaxSize) {
+		w.pass()
+	}
+	if w.passed {
+		return w.ResponseWriter.Write(data)
+	}
+
+	w.WriteHeaderNow()
+	n, err := w.body.Write(data)
+	w.size += n
+	return n, err
+}
+
+// WriteString writes a string to the response body
+func (w *validationWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Status returns the status code of the response
+func (w *validationWriter) Status() int {
+	if w.passed {
+		return w.ResponseWriter.Status()
+	}
+	return w.status
+}
+
+// Size returns the size of the response body
+func (w *validationWriter) Size() int {
+	if w.passed {
+		return w.ResponseWriter.Size()
+	}
+	return w.size
+}
+
+// Written returns true if the headers were written
+func (w *validationWriter) Written() bool {
+	if w.passed {
+		return w.ResponseWriter.Written()
+	}
+	return w.size != noWritten
+}
+
+// Flush sends the response written so far, unless it is a JSON body held back until the
+// handler returns
+func (w *validationWriter) Flush() {
+	if !w.passed && !openapi.IsJSON(w.Header().Get("Content-Type")) {
+		w.pass()
+	}
+	if w.passed {
+		w.ResponseWriter.Flush()
+	}
+}
+
+// Hijack takes over the connection, whose response is no longer validated
+func (w *validationWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.passed = true
+	return w.ResponseWriter.Hijack()
+}
+
+// pass stops validating the response, sending what was held back of it
+func (w *validationWriter) pass() {
+	w.passed = true
+	w.send()
+}
+
+// send writes the status code and the body held back
+func (w *validationWriter) send() {
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.WriteHeaderNow()
+	if w.body.Len() > 0 {
+		w.ResponseWriter.Write(w.body.Bytes())
+	}
+	w.body = bytes.Buffer{}
+}
+
+// decodeBody returns a response body without its content encoding
+func decodeBody(encoding string, body []byte) ([]byte, error) {
+	var reader io.Reader
+	switch encoding {
+	case "":
+		return body, nil
+	case "gzip":
+		gzipReader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("decode gzip body: %w", err)
+		}
+		reader = gzipReader
+	case "br":
+		reader = brotli.NewReader(bytes.NewReader(body))
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s body: %w", encoding, err)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"data-server/internal/adapters/input/http/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+const testSpec = `
+openapi: 3.0.3
+info:
+  title: Test
+  version: "1"
+paths:
+  /item:
+    get:
+      responses:
+        '200':
+          description: An item
+          content:
+            application/json:
+              schema:
+                type: object
+                required: [name]
+                properties:
+                  name:
+                    type: string
+  /export:
+    get:
+      responses:
+        '200':
+          description: An export
+          content:
+            text/csv:
+              schema:
+                type: string
+`
+
+func TestValidateResponses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	spec, err := openapi.Load([]byte(testSpec))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		target  string
+		handler gin.HandlerFunc
+		status  int
+		body    string
+	}{
+		{
+			name:   "a valid JSON body is sent unchanged",
+			target: "/item",
+			handler: func(c *gin.Context) {
+				c.Header("ETag", `"v1"`)
+				c.JSON(http.StatusOK, gin.H{"name": "good"})
+			},
+			status: http.StatusOK,
+			body:   `{"name":"good"}`,
+		},
+		{
+			name:    "an invalid JSON body is replaced by a problem",
+			target:  "/item",
+			handler: func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"name": 1}) },
+			status:  http.StatusInternalServerError,
+			body:    `"code":"invalid_response"`,
+		},
+		{
+			name:   "other media types are streamed through unvalidated",
+			target: "/export",
+			handler: func(c *gin.Context) {
+				c.Header("Content-Type", "text/csv")
+				c.Status(http.StatusOK)
+				c.Writer.WriteString("block,event\n")
+				c.Writer.Flush()
+				c.Writer.WriteString("1,staking.Rewarded\n")
+			},
+			status: http.StatusOK,
+			body:   "block,event\n1,staking.Rewarded\n",
+		},
+		{
+			name:    "undocumented server errors pass",
+			target:  "/item",
+			handler: func(c *gin.Context) { c.JSON(http.StatusServiceUnavailable, gin.H{"title": "Unavailable"}) },
+			status:  http.StatusServiceUnavailable,
+			body:    `{"title":"Unavailable"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(ValidateResponses(spec))
+			r.GET(tt.target, tt.handler)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if w.Code != tt.status {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.status, w.Body.String())
+			}
+			if !strings.Contains(w.Body.String(), tt.body) {
+				t.Errorf("body = %q, want it to contain %q", w.Body.String(), tt.body)
+			}
+			if tt.target == "/export" && !w.Flushed {
+				t.Error("the streamed body was not flushed")
+			}
+			if tt.status == http.StatusOK && strings.HasPrefix(tt.body, "{") && (w.Body.String() != tt.body || w.Header().Get("ETag") != `"v1"`) {
+				t.Errorf("body = %q with ETag %q, want the response unchanged", w.Body.String(), w.Header().Get("ETag"))
+			}
+		})
+	}
+}
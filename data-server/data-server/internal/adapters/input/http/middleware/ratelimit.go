@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+
+	"data-server/internal/domain/entities"
+	"data-server/pkg/ratelimit"
+	"data-server/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimit limits the requests of every client with a token bucket. Clients are
+// identified by their API key, or by their IP address when they have none
+type RateLimit struct {
+	limiter *ratelimit.Limiter
+	policy  ratelimit.Policy
+	costs   ratelimit.Costs
+}
+
+// NewRateLimit creates a new rate limiting middleware, costing requests by their route
+// pattern and block range
+func NewRateLimit(limiter *ratelimit.Limiter, policy ratelimit.Policy, costs ratelimit.Costs) *RateLimit {
+	return &RateLimit{
+		limiter: limiter,
+		policy:  policy,
+		costs:   costs,
+	}
+}
+
+// Handler returns a middleware taking the cost of every request from the bucket of its
+// client, and rejecting the request with 429 Too Many Requests if the bucket holds too
+// few tokens. It must run after the principal is attached to the request context
+func (m *RateLimit) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if m.take(c, m.cost(c)) {
+			c.Next()
+		}
+	}
+}
+
+// cost returns the cost of a request, given by its route pattern and block range
+func (m *RateLimit) cost(c *gin.Context) int {
+	return m.costs.Cost(c.FullPath(), blockRangeSpan(c))
+}
+
+// Charge takes the cost of the work a handler only knows once it has parsed the request,
+// such as the complexity of a GraphQL query, on top of the cost of the request. It
+// returns false after rejecting the request with 429 Too Many Requests
+func (m *RateLimit) Charge(c *gin.Context, cost int) bool {
+	if cost <= 0 {
+		return true
+	}
+	return m.take(c, m.costs.Cap(cost))
+}
+
+// take takes cost tokens from the bucket of the client of a request, setting the
+// RateLimit headers, and returns false after rejecting the request if it holds too few
+func (m *RateLimit) take(c *gin.Context, cost int) bool {
+	keyID, keyRateLimit := "", 0
+	if principal, ok := entities.PrincipalFromContext(c.Request.Context()); ok {
+		keyID, keyRateLimit = principal.KeyID, principal.RateLimit
+	}
+
+	client, rate := m.policy.Client(keyID, keyRateLimit, c.ClientIP())
+	decision := m.limiter.Allow(client, rate, cost)
+	if !rate.Unlimited() {
+		header := c.Writer.Header()
+		header.Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+		header.Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+		header.Set("RateLimit-Reset", strconv.Itoa(int(math.Ceil(decision.Reset.Seconds()))))
+		header.Set("RateLimit-Policy", strconv.Itoa(decision.Limit)+";w="+strconv.Itoa(int(math.Ceil(rate.Window().Seconds()))))
+	}
+
+	if !decision.Allowed {
+		response.TooManyRequests(c, "Rate limit exceeded", decision.RetryAfter)
+		return false
+	}
+	return true
+}
+
+// TrustProxies sets where the engine reads the client IP of a request, which identifies
+// the clients without API key: from the header of the trusted platform when there is one,
+// from X-Forwarded-For only when the request comes from a trusted proxy, and from the
+// address of the connection otherwise, so clients cannot pick their bucket
+func TrustProxies(r *gin.Engine, platform string, proxies []string) error {
+	r.TrustedPlatform = platform
+	if len(proxies) == 0 {
+		proxies = nil
+	}
+	return r.SetTrustedProxies(proxies)
+}
+
+// blockRangeSpan returns the number of blocks of the block range of a request, and zero
+// if it has none
+func blockRangeSpan(c *gin.Context) int {
+	startBlock, endBlock, ok := blockRange(c)
+	if !ok || endBlock <= startBlock {
+		return 0
+	}
+	return endBlock - startBlock
+}
+
+// blockRange returns the block range of a request, given by the start and end path
+// parameters or else by the query parameters, and false if it has none
+func blockRange(c *gin.Context) (int, int, bool) {
+	start, end := c.Param("start"), c.Param("end")
+	if start == "" && end == "" {
+		start, end = c.Query("start"), c.Query("end")
+	}
+
+	startBlock, startErr := strconv.Atoi(start)
+	endBlock, endErr := strconv.Atoi(end)
+	return startBlock, endBlock, startErr == nil && endErr == nil
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"data-server/pkg/ratelimit"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRateLimitClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// request is sent from a remote address with the given headers
+	type request struct {
+		remoteAddr string
+		header     map[string]string
+		status     int
+	}
+
+	tests := []struct {
+		name     string
+		platform string
+		proxies  []string
+		requests []request
+	}{
+		{
+			name: "a spoofed X-Forwarded-For does not change the bucket",
+			requests: []request{
+				{remoteAddr: "203.0.113.7:1234", header: map[string]string{"X-Forwarded-For": "198.51.100.1"}, status: http.StatusOK},
+				{remoteAddr: "203.0.113.7:1234", header: map[string]string{"X-Forwarded-For": "198.51.100.2"}, status: http.StatusTooManyRequests},
+				{remoteAddr: "203.0.113.7:1234", status: http.StatusTooManyRequests},
+			},
+		},
+		{
+			name:    "X-Forwarded-For is read from a trusted proxy",
+			proxies: []string{"10.0.0.0/8"},
+			requests: []request{
+				{remoteAddr: "10.0.0.2:1234", header: map[string]string{"X-Forwarded-For": "198.51.100.1"}, status: http.StatusOK},
+				{remoteAddr: "10.0.0.2:1234", header: map[string]string{"X-Forwarded-For": "198.51.100.2"}, status: http.StatusOK},
+				{remoteAddr: "10.0.0.3:1234", header: map[string]string{"X-Forwarded-For": "198.51.100.1"}, status: http.StatusTooManyRequests},
+			},
+		},
+		{
+			name:    "X-Forwarded-For is ignored from other addresses than the trusted proxies",
+			proxies: []string{"10.0.0.0/8"},
+			requests: []request{
+				{remoteAddr: "203.0.113.7:1234", header: map[string]string{"X-Forwarded-For": "198.51.100.1"}, status: http.StatusOK},
+				{remoteAddr: "203.0.113.7:1234", header: map[string]string{"X-Forwarded-For": "198.51.100.2"}, status: http.StatusTooManyRequests},
+			},
+		},
+		{
+			name:     "the client IP is read from the trusted platform header",
+			platform: "Fly-Client-IP",
+			requests: []request{
+				{remoteAddr: "10.0.0.2:1234", header: map[string]string{"Fly-Client-IP": "198.51.100.1", "X-Forwarded-For": "198.51.100.3"}, status: http.StatusOK},
+				{remoteAddr: "10.0.0.2:1234", header: map[string]string{"Fly-Client-IP": "198.51.100.2", "X-Forwarded-For": "198.51.100.3"}, status: http.StatusOK},
+				{remoteAddr: "10.0.0.3:1234", header: map[string]string{"Fly-Client-IP": "198.51.100.1", "X-Forwarded-For": "198.51.100.4"}, status: http.StatusTooManyRequests},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			if err := TrustProxies(r, tt.platform, tt.proxies); err != nil {
+				t.Fatalf("TrustProxies() error = %v", err)
+			}
+			policy := ratelimit.Policy{IP: ratelimit.Rate{PerMinute: 1, Burst: 1}}
+			r.Use(NewRateLimit(ratelimit.NewLimiter(), policy, ratelimit.Costs{}).Handler())
+			r.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			for i, req := range tt.requests {
+				httpReq := httptest.NewRequest(http.MethodGet, "/", nil)
+				httpReq.RemoteAddr = req.remoteAddr
+				for name, value := range req.header {
+					httpReq.Header.Set(name, value)
+				}
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, httpReq)
+
+				if w.Code != req.status {
+					t.Errorf("request %d status = %d, want %d", i, w.Code, req.status)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+// Package openapi checks the HTTP API against its OpenAPI specification: the routes of
+// the router against the documented operations, and the requests and responses against
+// the documented parameters and schemas
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"data-server/internal/domain/domainerr"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gin-gonic/gin"
+)
+
+// ErrInvalidParameter is returned when a request parameter does not match the spec
+var ErrInvalidParameter = domainerr.New(domainerr.InvalidInput, "invalid_parameter", "invalid request parameter")
+
+// pathParameter matches the parameters of the OpenAPI paths, e.g. {type}
+var pathParameter = regexp.MustCompile(`\{([^}/]+)\}`)
+
+// Spec represents a loaded OpenAPI specification, whose operations are looked up by the
+// method and the route path of the router, e.g. GET /api/v1/validators/:type
+type Spec struct {
+	raw    []byte
+	routes map[string]*routers.Route
+}
+
+// Load parses and validates an OpenAPI specification. Schema errors are reported without
+// the schema and the value they concern from then on, which would flood the logs
+func Load(data []byte) (*Spec, error) {
+	openapi3.SchemaErrorDetailsDisabled = true
+
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse OpenAPI spec: %w", err)
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI spec: %w", err)
+	}
+
+	spec := &Spec{raw: data, routes: make(map[string]*routers.Route)}
+	for path, pathItem := range doc.Paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			spec.routes[routeKey(method, RoutePath(path))] = &routers.Route{
+				Spec:      doc,
+				Path:      path,
+				PathItem:  pathItem,
+				Method:    method,
+				Operation: operation,
+			}
+		}
+	}
+	return spec, nil
+}
+
+// Raw returns the specification as it was loaded
+func (s *Spec) Raw() []byte {
+	return s.raw
+}
+
+// RoutePath converts an OpenAPI path to the path of a router route, e.g.
+// /validators/{type} to /validators/:type
+func RoutePath(path string) string {
+	return pathParameter.ReplaceAllString(path, ":$1")
+}
+
+// routeKey returns the key of the operation of a method on a route path
+func routeKey(method, routePath string) string {
+	return strings.ToUpper(method) + " " + routePath
+}
+
+// CheckRoutes verifies that every route of the router is a documented operation and that
+// every documented operation is routed, listing all the differences in the error
+func (s *Spec) CheckRoutes(routes gin.RoutesInfo) error {
+	routed := make(map[string]bool, len(routes))
+	var problems []string
+	for _, route := range routes {
+		key := routeKey(route.Method, route.Path)
+		routed[key] = true
+		if _, ok := s.routes[key]; !ok {
+			problems = append(problems, key+" is not documented")
+		}
+	}
+	for key := range s.routes {
+		if !routed[key] {
+			problems = append(problems, key+" is documented but not routed")
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(problems)
+	return fmt.Errorf("routes do not match the OpenAPI spec: %s", strings.Join(problems, "; "))
+}
+
+// ValidateRequest validates the path, query and header parameters of a request against
+// the operation of its route. Requests to unknown routes are left to the router, the
+// bodies and credentials are validated by the handlers and the authentication
+func (s *Spec) ValidateRequest(c *gin.Context) error {
+	input := s.requestInput(c)
+	if input == nil {
+		return nil
+	}
+
+	if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidParameter, requestErrorDetail(err))
+	}
+	return nil
+}
+
+// ValidateResponse validates the status, headers and JSON body of a response against the
+// operation of the route of its request. Bodies of other media types, such as exports
+// and streams, are not validated, nor are the statuses of the unexpected server errors
+// which the operations do not document
+func (s *Spec) ValidateResponse(c *gin.Context, status int, header http.Header, body []byte) error {
+	input := s.requestInput(c)
+	if input == nil {
+		return nil
+	}
+
+	options := *input.Options
+	options.IncludeResponseStatus = status < http.StatusInternalServerError
+	options.ExcludeResponseBody = !IsJSON(header.Get("Content-Type"))
+
+	return openapi3filter.ValidateResponse(context.Background(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 status,
+		Header:                 header,
+		Body:                   io.NopCloser(bytes.NewReader(body)),
+		Options:                &options,
+	})
+}
+
+// requestInput returns the validation input of a request, or nil if its route is not
+// documented
+func (s *Spec) requestInput(c *gin.Context) *openapi3filter.RequestValidationInput {
+	route, ok := s.routes[routeKey(c.Request.Method, c.FullPath())]
+	if !ok {
+		return nil
+	}
+
+	pathParams := make(map[string]string, len(c.Params))
+	for _, param := range c.Params {
+		pathParams[param.Key] = param.Value
+	}
+
+	return &openapi3filter.RequestValidationInput{
+		Request:    c.Request,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			ExcludeRequestBody: true,
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			MultiError:         true,
+		},
+	}
+}
+
+// requestErrorDetail returns the reasons of the parameter errors of a failed request
+// validation, without the schemas they were validated against
+func requestErrorDetail(err error) string {
+	var multi openapi3.MultiError
+	if !errors.As(err, &multi) {
+		return parameterErrorDetail(err)
+	}
+
+	details := make([]string, 0, len(multi))
+	for _, err := range multi {
+		details = append(details, parameterErrorDetail(err))
+	}
+	return strings.Join(details, "; ")
+}
+
+// parameterErrorDetail returns the reason of a parameter error, e.g. parameter "limit" in
+// query: number must be at most 1000
+func parameterErrorDetail(err error) string {
+	var requestErr *openapi3filter.RequestError
+	if !errors.As(err, &requestErr) || requestErr.Parameter == nil {
+		return err.Error()
+	}
+
+	reason := requestErr.Reason
+	var schemaErr *openapi3.SchemaError
+	var parseErr *openapi3filter.ParseError
+	switch {
+	case errors.As(requestErr.Err, &schemaErr):
+		reason = schemaErr.Reason
+	case errors.As(requestErr.Err, &parseErr):
+		reason = parseErr.Reason
+	case requestErr.Err != nil && reason == "":
+		reason = requestErr.Err.Error()
+	}
+	return fmt.Sprintf("parameter %q in %s: %s", requestErr.Parameter.Name, requestErr.Parameter.In, reason)
+}
+
+// IsJSON reports whether a content type is JSON, including problem details
+func IsJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
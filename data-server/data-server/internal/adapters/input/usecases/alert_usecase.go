@@ -0,0 +1,319 @@
+package usecases
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+	"data-server/internal/ports/output"
+	"data-server/pkg/logger"
+)
+
+const (
+	// alertEvaluationInterval is how often every rule is evaluated for every validator
+	alertEvaluationInterval = 30 * time.Second
+
+	// alertBusBuffer is the number of events the evaluator may have pending on the bus
+	alertBusBuffer = 1024
+)
+
+// AlertUseCase implements the AlertService interface and evaluates the alert rules
+type AlertUseCase struct {
+	validatorRepo output.ValidatorRepository
+	ruleRepo      output.AlertRuleRepository
+	alertRepo     output.AlertRepository
+	eventBus      output.EventBus
+	fileRules     []entities.AlertRule
+	mutex         sync.Mutex
+}
+
+// NewAlertUseCase creates a new alert use case. Rules loaded from the rules file are
+// evaluated alongside the rules of the repository but cannot be modified
+func NewAlertUseCase(validatorRepo output.ValidatorRepository, ruleRepo output.AlertRuleRepository, alertRepo output.AlertRepository, eventBus output.EventBus, fileRules []entities.AlertRule) *AlertUseCase {
+	return &AlertUseCase{
+		validatorRepo: validatorRepo,
+		ruleRepo:      ruleRepo,
+		alertRepo:     alertRepo,
+		eventBus:      eventBus,
+		fileRules:     fileRules,
+	}
+}
+
+// GetAlerts retrieves the firing and resolved alerts matching the query
+func (uc *AlertUseCase) GetAlerts(ctx context.Context, query entities.AlertQuery, page valueobjects.PageRequest) (*input.Page[entities.Alert], error) {
+	alerts, err := uc.alertRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := []entities.Alert{}
+	for _, alert := range alerts {
+		if query.Matches(alert) {
+			matched = append(matched, alert)
+		}
+	}
+
+	return newPage(matched, page)
+}
+
+// GetAlert retrieves an alert
+func (uc *AlertUseCase) GetAlert(ctx context.Context, id string) (*entities.Alert, error) {
+	return uc.alertRepo.GetByID(ctx, id)
+}
+
+// GetAlertRules retrieves the alert rules from the rules file and the API
+func (uc *AlertUseCase) GetAlertRules(ctx context.Context, page valueobjects.PageRequest) (*input.Page[entities.AlertRule], error) {
+	rules, err := uc.rules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(rules, page)
+}
+
+// GetAlertRule retrieves an alert rule
+func (uc *AlertUseCase) GetAlertRule(ctx context.Context, id string) (*entities.AlertRule, error) {
+	if rule := uc.fileRule(id); rule != nil {
+		return rule, nil
+	}
+
+	return uc.ruleRepo.GetRuleByID(ctx, id)
+}
+
+// CreateAlertRule creates an alert rule and evaluates it for every validator
+func (uc *AlertUseCase) CreateAlertRule(ctx context.Context, request input.AlertRuleRequest) (*entities.AlertRule, error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	rule := entities.AlertRule{
+		ID:        "rule_" + randomHex(8),
+		Source:    entities.AlertRuleSourceAPI,
+		CreatedAt: now,
+	}
+	applyAlertRuleRequest(&rule, request, true)
+	rule.UpdatedAt = now
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepo.SaveRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	if err := uc.EvaluateAll(ctx); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// UpdateAlertRule replaces an alert rule created through the API and evaluates it for
+// every validator
+func (uc *AlertUseCase) UpdateAlertRule(ctx context.Context, id string, request input.AlertRuleRequest) (*entities.AlertRule, error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	if uc.fileRule(id) != nil {
+		return nil, entities.ErrAlertRuleReadOnly
+	}
+
+	rule, err := uc.ruleRepo.GetRuleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	applyAlertRuleRequest(rule, request, rule.Enabled)
+	rule.UpdatedAt = time.Now().UTC()
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepo.SaveRule(ctx, *rule); err != nil {
+		return nil, err
+	}
+	if err := uc.EvaluateAll(ctx); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteAlertRule deletes an alert rule created through the API, resolving its alerts
+func (uc *AlertUseCase) DeleteAlertRule(ctx context.Context, id string) error {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return err
+	}
+
+	if uc.fileRule(id) != nil {
+		return entities.ErrAlertRuleReadOnly
+	}
+
+	if err := uc.ruleRepo.DeleteRule(ctx, id); err != nil {
+		return err
+	}
+
+	return uc.EvaluateAll(ctx)
+}
+
+// Run evaluates the rules for the validator of every event published on the bus, and
+// for every validator on a schedule, until the context is canceled
+func (uc *AlertUseCase) Run(ctx context.Context) {
+	ctx = logger.With(ctx, slog.String("worker", "alert_evaluator"))
+	subscription := uc.eventBus.Subscribe(alertBusBuffer)
+	defer func() { subscription.Cancel() }()
+
+	if err := uc.EvaluateAll(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to evaluate alert rules", "error", err)
+	}
+
+	ticker := time.NewTicker(alertEvaluationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-subscription.Events():
+			if !ok {
+				// The scheduled evaluation catches up on the events dropped meanwhile
+				slog.WarnContext(ctx, "Alert evaluator fell behind the event bus, resubscribing")
+				subscription = uc.eventBus.Subscribe(alertBusBuffer)
+				continue
+			}
+			validator, err := uc.validatorRepo.GetByStash(ctx, event.Stash)
+			if err != nil {
+				continue
+			}
+			if err := uc.evaluate(ctx, validator); err != nil {
+				slog.ErrorContext(ctx, "Failed to evaluate alert rules", "validator", event.Stash, "error", err)
+			}
+		case <-ticker.C:
+			if err := uc.EvaluateAll(ctx); err != nil {
+				slog.ErrorContext(ctx, "Failed to evaluate alert rules", "error", err)
+			}
+		}
+	}
+}
+
+// EvaluateAll evaluates the rules for every validator
+func (uc *AlertUseCase) EvaluateAll(ctx context.Context) error {
+	validators, err := uc.validatorRepo.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	return uc.evaluate(ctx, validators...)
+}
+
+// evaluate evaluates the enabled rules for the given validators. A rule holding for a
+// validator fires an alert, or refreshes the one already firing, and the firing alert is
+// resolved once the rule no longer holds, applies or exists
+func (uc *AlertUseCase) evaluate(ctx context.Context, validators ...*entities.Validator) error {
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	rules, err := uc.rules(ctx)
+	if err != nil {
+		return err
+	}
+	alerts, err := uc.alertRepo.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	firing := map[string]entities.Alert{}
+	for _, alert := range alerts {
+		if alert.State == entities.AlertStateFiring {
+			firing[alert.Fingerprint] = alert
+		}
+	}
+
+	now := time.Now().UTC()
+	var changed []entities.Alert
+	for _, validator := range validators {
+		block := validator.LatestBlock()
+		evaluated := map[string]bool{}
+
+		for i := range rules {
+			rule := &rules[i]
+			if !rule.Enabled || !rule.AppliesTo(validator.Stash) {
+				continue
+			}
+			fingerprint := entities.AlertFingerprint(rule.ID, validator.Stash)
+			evaluated[fingerprint] = true
+
+			condition := rule.Evaluate(validator)
+			alert, ok := firing[fingerprint]
+			switch {
+			case condition.Active && !ok:
+				alert = *entities.NewAlert("alert_"+randomHex(12), rule, validator.Stash, condition, block, now)
+				slog.InfoContext(ctx, "Alert firing", "rule", rule.ID, "validator", validator.Stash, "block", block, "message", condition.Message)
+			case condition.Active:
+				alert.Refresh(condition, now)
+			case ok:
+				alert.Resolve(block, now)
+				slog.InfoContext(ctx, "Alert resolved", "rule", rule.ID, "validator", validator.Stash, "block", block)
+			default:
+				continue
+			}
+			changed = append(changed, alert)
+		}
+
+		for fingerprint, alert := range firing {
+			if alert.Stash == validator.Stash && !evaluated[fingerprint] {
+				alert.Resolve(block, now)
+				changed = append(changed, alert)
+				slog.InfoContext(ctx, "Alert resolved, the rule no longer applies", "rule", alert.RuleID, "validator", validator.Stash, "block", block)
+			}
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return uc.alertRepo.Save(ctx, changed...)
+}
+
+// rules returns the rules of the rules file followed by the rules of the repository
+func (uc *AlertUseCase) rules(ctx context.Context) ([]entities.AlertRule, error) {
+	stored, err := uc.ruleRepo.GetAllRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]entities.AlertRule, 0, len(uc.fileRules)+len(stored))
+	rules = append(rules, uc.fileRules...)
+	return append(rules, stored...), nil
+}
+
+// fileRule returns the rule of the rules file with the given identifier, or nil
+func (uc *AlertUseCase) fileRule(id string) *entities.AlertRule {
+	for _, rule := range uc.fileRules {
+		if rule.ID == id {
+			return &rule
+		}
+	}
+	return nil
+}
+
+// applyAlertRuleRequest copies the settings of a request to a rule. The rule keeps its
+// enabled state when the request does not set it
+func applyAlertRuleRequest(rule *entities.AlertRule, request input.AlertRuleRequest, enabled bool) {
+	rule.Name = request.Name
+	rule.Description = request.Description
+	rule.Kind = request.Kind
+	rule.Severity = request.Severity
+	rule.Threshold = request.Threshold
+	rule.Window = request.Window
+	rule.Kinds = request.Kinds
+	rule.Stashes = request.Stashes
+	rule.Enabled = enabled
+	if request.Enabled != nil {
+		rule.Enabled = *request.Enabled
+	}
+}
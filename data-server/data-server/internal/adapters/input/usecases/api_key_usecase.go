@@ -0,0 +1,281 @@
+package usecases
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+	"data-server/internal/ports/output"
+)
+
+const (
+	// apiKeyUsageFlushInterval is how often the usage counters are written to the repository
+	apiKeyUsageFlushInterval = 30 * time.Second
+
+	// bootstrapKeyID identifies the principal of the bootstrap key
+	bootstrapKeyID = "bootstrap"
+)
+
+// apiKeyActivity tracks the requests of an API key between two usage flushes
+type apiKeyActivity struct {
+	requests   int64
+	lastUsedAt time.Time
+}
+
+// APIKeyUseCase implements the APIKeyService interface. Usage counters are kept in
+// memory, so authenticating does not write to the repository, and flushed by Run
+type APIKeyUseCase struct {
+	apiKeyRepo    output.APIKeyRepository
+	bootstrapHash string
+	activity      map[string]*apiKeyActivity
+	activityMutex sync.Mutex
+	mutex         sync.Mutex
+}
+
+// NewAPIKeyUseCase creates a new API key use case. A non empty bootstrap secret is
+// accepted as an admin key, so the first keys can be created
+func NewAPIKeyUseCase(apiKeyRepo output.APIKeyRepository, bootstrapSecret string) *APIKeyUseCase {
+	uc := &APIKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		activity:   make(map[string]*apiKeyActivity),
+	}
+	if bootstrapSecret != "" {
+		uc.bootstrapHash = entities.HashAPIKey(bootstrapSecret)
+	}
+	return uc
+}
+
+// Authenticate returns the principal of an API key secret, counting the request in the
+// usage of the key
+func (uc *APIKeyUseCase) Authenticate(ctx context.Context, secret string) (*entities.Principal, error) {
+	hash := entities.HashAPIKey(secret)
+	if uc.bootstrapHash != "" && subtle.ConstantTimeCompare([]byte(hash), []byte(uc.bootstrapHash)) == 1 {
+		return &entities.Principal{
+			KeyID:  bootstrapKeyID,
+			Name:   bootstrapKeyID,
+			Scopes: []entities.APIKeyScope{entities.ScopeAdmin},
+		}, nil
+	}
+
+	key, err := uc.apiKeyRepo.GetByHash(ctx, hash)
+	if errors.Is(err, entities.ErrAPIKeyNotFound) {
+		return nil, fmt.Errorf("%w: unknown API key", entities.ErrUnauthenticated)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if key.Expired(now) {
+		return nil, fmt.Errorf("%w: API key expired", entities.ErrUnauthenticated)
+	}
+	uc.record(key, now)
+
+	return key.Principal(), nil
+}
+
+// CreateAPIKey creates an API key with a random secret, only returned by this call
+func (uc *APIKeyUseCase) CreateAPIKey(ctx context.Context, request input.APIKeyRequest) (*input.CreatedAPIKey, error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	secret := entities.APIKeyPrefix + randomHex(24)
+	now := time.Now().UTC()
+	key := entities.APIKey{
+		ID:        "key_" + randomHex(8),
+		Prefix:    entities.APIKeyDisplayPrefix(secret),
+		Hash:      entities.HashAPIKey(secret),
+		CreatedAt: now,
+	}
+	applyAPIKeyRequest(&key, request)
+	key.UpdatedAt = now
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	if err := uc.apiKeyRepo.Save(ctx, key); err != nil {
+		return nil, err
+	}
+
+	key.Hash = ""
+	return &input.CreatedAPIKey{APIKey: key, Secret: secret}, nil
+}
+
+// GetAPIKeys retrieves the API keys with their current usage
+func (uc *APIKeyUseCase) GetAPIKeys(ctx context.Context, page valueobjects.PageRequest) (*input.Page[entities.APIKey], error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	keys, err := uc.apiKeyRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range keys {
+		uc.present(&keys[i])
+	}
+
+	return newPage(keys, page)
+}
+
+// GetAPIKey retrieves an API key with its current usage
+func (uc *APIKeyUseCase) GetAPIKey(ctx context.Context, id string) (*entities.APIKey, error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	key, err := uc.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.present(key)
+	return key, nil
+}
+
+// UpdateAPIKey replaces the name, scopes, rate limit and expiry of an API key, keeping
+// its secret
+func (uc *APIKeyUseCase) UpdateAPIKey(ctx context.Context, id string, request input.APIKeyRequest) (*entities.APIKey, error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	key, err := uc.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	applyAPIKeyRequest(key, request)
+	key.UpdatedAt = time.Now().UTC()
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.apiKeyRepo.Save(ctx, *key); err != nil {
+		return nil, err
+	}
+
+	uc.present(key)
+	return key, nil
+}
+
+// DeleteAPIKey revokes an API key, requests made with it are rejected immediately
+func (uc *APIKeyUseCase) DeleteAPIKey(ctx context.Context, id string) error {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return err
+	}
+
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	if err := uc.apiKeyRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	uc.activityMutex.Lock()
+	delete(uc.activity, id)
+	uc.activityMutex.Unlock()
+	return nil
+}
+
+// Run writes the usage counters to the repository periodically, and a last time when
+// the context is canceled
+func (uc *APIKeyUseCase) Run(ctx context.Context) {
+	ticker := time.NewTicker(apiKeyUsageFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			uc.flush(context.Background())
+			return
+		case <-ticker.C:
+			uc.flush(ctx)
+		}
+	}
+}
+
+// record counts a request of an API key
+func (uc *APIKeyUseCase) record(key *entities.APIKey, now time.Time) {
+	uc.activityMutex.Lock()
+	defer uc.activityMutex.Unlock()
+
+	activity, ok := uc.activity[key.ID]
+	if !ok {
+		activity = &apiKeyActivity{}
+		uc.activity[key.ID] = activity
+	}
+
+	activity.requests++
+	activity.lastUsedAt = now
+}
+
+// flush adds the requests counted since the last flush to the usage of the keys
+func (uc *APIKeyUseCase) flush(ctx context.Context) {
+	uc.activityMutex.Lock()
+	pending := make(map[string]apiKeyActivity)
+	for id, activity := range uc.activity {
+		if activity.requests > 0 {
+			pending[id] = *activity
+			activity.requests = 0
+		}
+	}
+	uc.activityMutex.Unlock()
+
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	for id, activity := range pending {
+		key, err := uc.apiKeyRepo.GetByID(ctx, id)
+		if errors.Is(err, entities.ErrAPIKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to get API key", "key_id", id, "error", err)
+			continue
+		}
+
+		lastUsedAt := activity.lastUsedAt
+		key.Usage.Requests += activity.requests
+		key.Usage.LastUsedAt = &lastUsedAt
+		if err := uc.apiKeyRepo.Save(ctx, *key); err != nil {
+			slog.ErrorContext(ctx, "Failed to save API key usage", "key_id", id, "error", err)
+		}
+	}
+}
+
+// present hides the hash of an API key and adds the requests not flushed yet to its usage
+func (uc *APIKeyUseCase) present(key *entities.APIKey) {
+	key.Hash = ""
+
+	uc.activityMutex.Lock()
+	defer uc.activityMutex.Unlock()
+
+	if activity, ok := uc.activity[key.ID]; ok && activity.requests > 0 {
+		lastUsedAt := activity.lastUsedAt
+		key.Usage.Requests += activity.requests
+		key.Usage.LastUsedAt = &lastUsedAt
+	}
+}
+
+// applyAPIKeyRequest copies the settings of a request to an API key
+func applyAPIKeyRequest(key *entities.APIKey, request input.APIKeyRequest) {
+	key.Name = request.Name
+	key.Scopes = request.Scopes
+	key.RateLimit = request.RateLimit
+	key.ExpiresAt = request.ExpiresAt
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+	"data-server/internal/ports/output"
+)
+
+// EpochUseCase implements the EpochService interface
+type EpochUseCase struct {
+	validatorRepo output.ValidatorRepository
+}
+
+// NewEpochUseCase creates a new epoch use case
+func NewEpochUseCase(validatorRepo output.ValidatorRepository) *EpochUseCase {
+	return &EpochUseCase{
+		validatorRepo: validatorRepo,
+	}
+}
+
+// GetEpochs retrieves the BABE epochs ordered by index
+func (uc *EpochUseCase) GetEpochs(ctx context.Context, page valueobjects.PageRequest) (*input.Page[entities.Epoch], error) {
+	validators, err := uc.validatorRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(entities.BuildEpochs(validators), page)
+}
@@ -2,20 +2,30 @@ package usecases
 
 import (
 	"context"
+	"strconv"
+
 	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
 	"data-server/internal/ports/input"
 	"data-server/internal/ports/output"
 )
 
 type EventUseCase struct {
 	validatorRepo output.ValidatorRepository
+	tracer        output.Tracer
+}
+
+// NewEventUseCase creates a new event use case, tracing the classification of the
+// events computing their statistics
+func NewEventUseCase(validatorRepo output.ValidatorRepository, tracer output.Tracer) *EventUseCase {
+	return &EventUseCase{validatorRepo: validatorRepo, tracer: tracer}
 }
 
-func NewEventUseCase(validatorRepo output.ValidatorRepository) *EventUseCase {
-	return &EventUseCase{validatorRepo: validatorRepo}
+func (uc *EventUseCase) GetAllEvents(ctx context.Context, page valueobjects.PageRequest) (*input.Page[entities.Event], error) {
+	return uc.QueryEvents(ctx, entities.EventQuery{}, page)
 }
 
-func (uc *EventUseCase) GetAllEvents(ctx context.Context) ([]entities.Event, error) {
+func (uc *EventUseCase) allEvents(ctx context.Context) ([]entities.Event, error) {
 	validators, err := uc.validatorRepo.GetAll(ctx)
 	if err != nil {
 		return nil, err
@@ -27,66 +37,51 @@ func (uc *EventUseCase) GetAllEvents(ctx context.Context) ([]entities.Event, err
 	return events, nil
 }
 
-func (uc *EventUseCase) GetEventsByType(ctx context.Context, eventType string) ([]entities.Event, error) {
-	all, err := uc.GetAllEvents(ctx)
-	if err != nil {
-		return nil, err
-	}
-	var filtered []entities.Event
-	for _, e := range all {
-		if e.Event == eventType {
-			filtered = append(filtered, e)
-		}
-	}
-	return filtered, nil
+func (uc *EventUseCase) GetEventsByType(ctx context.Context, eventType string, page valueobjects.PageRequest) (*input.Page[entities.Event], error) {
+	return uc.QueryEvents(ctx, entities.EventQuery{Types: []string{eventType}}, page)
 }
 
-func (uc *EventUseCase) GetEventsByBlockRange(ctx context.Context, startBlock, endBlock int) ([]entities.Event, error) {
-	all, err := uc.GetAllEvents(ctx)
+func (uc *EventUseCase) GetEventsByBlockRange(ctx context.Context, startBlock, endBlock int, page valueobjects.PageRequest) (*input.Page[entities.Event], error) {
+	blockRange, err := valueobjects.NewBlockRange(startBlock, endBlock)
 	if err != nil {
 		return nil, err
 	}
-	var filtered []entities.Event
-	for _, e := range all {
-		if e.Block >= startBlock && e.Block <= endBlock {
-			filtered = append(filtered, e)
-		}
-	}
-	return filtered, nil
+	return uc.QueryEvents(ctx, entities.EventQuery{BlockRange: blockRange}, page)
 }
 
-func (uc *EventUseCase) GetEventsByCategory(ctx context.Context, category string) ([]entities.Event, error) {
-	all, err := uc.GetAllEvents(ctx)
-	if err != nil {
+func (uc *EventUseCase) GetEventsByCategory(ctx context.Context, category string, page valueobjects.PageRequest) (*input.Page[entities.Event], error) {
+	return uc.QueryEvents(ctx, entities.EventQuery{Categories: []string{category}}, page)
+}
+
+func (uc *EventUseCase) GetEventsByValidator(ctx context.Context, stash string, query entities.EventQuery, page valueobjects.PageRequest) (*input.Page[entities.Event], error) {
+	if err := entities.ValidateAddress(stash); err != nil {
 		return nil, err
 	}
-	var filtered []entities.Event
-	for _, e := range all {
-		if e.GetEventCategory() == category {
-			filtered = append(filtered, e)
-		}
+	if _, err := uc.validatorRepo.GetByStash(ctx, stash); err != nil {
+		return nil, err
 	}
-	return filtered, nil
+	query.Stashes = []string{stash}
+	return uc.QueryEvents(ctx, query, page)
 }
 
-func (uc *EventUseCase) GetEventsByValidator(ctx context.Context, stash string) ([]entities.Event, error) {
-	validators, err := uc.validatorRepo.GetAll(ctx)
-	if err != nil {
+func (uc *EventUseCase) QueryEvents(ctx context.Context, query entities.EventQuery, page valueobjects.PageRequest) (*input.Page[entities.Event], error) {
+	if err := query.Validate(); err != nil {
 		return nil, err
 	}
-	for _, v := range validators {
-		if v.Stash == stash {
-			return v.Events, nil
-		}
+	events, info, err := uc.validatorRepo.QueryEvents(ctx, query, page)
+	if err != nil {
+		return nil, err
 	}
-	return nil, nil
+	return pageOf(events, info), nil
 }
 
 func (uc *EventUseCase) GetEventStats(ctx context.Context) (*input.EventStats, error) {
-	all, err := uc.GetAllEvents(ctx)
+	all, err := uc.allEvents(ctx)
 	if err != nil {
 		return nil, err
 	}
+	_, span := uc.tracer.Start(ctx, "EventStats.classify", map[string]string{"events": strconv.Itoa(len(all))})
+	defer span.End(nil)
 	stats := &input.EventStats{
 		EventsByType:     map[string]int{},
 		EventsByCategory: map[string]int{},
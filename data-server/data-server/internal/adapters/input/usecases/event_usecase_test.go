@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"data-server/internal/adapters/output/memory"
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/expression"
+	"data-server/internal/domain/valueobjects"
+)
+
+func TestQueryEventsLimitsTheFilterCostOfEachEvent(t *testing.T) {
+	ctx := context.Background()
+
+	// The filter costs 60 to 80 per event, more than a million over all the events
+	const events = 20000
+	validator := entities.NewValidator("5F3sa2TJc...Good", entities.ValidatorTypeGood, "")
+	tags := []interface{}{"a", "b", "c", "d", "e", "f", "g", "h", "i", "payout"}
+	for block := 1; block <= events; block++ {
+		validator.AddEvent(*entities.NewEvent(block, "staking.Rewarded", map[string]interface{}{"tags": tags}))
+	}
+	validators := memory.NewEmptyValidatorRepository()
+	if err := validators.Save(ctx, validator); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	filter, err := expression.Compile(`data.tags.exists(t, t == "payout")`)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+
+	// Querying events does not trace, so the use case goes without a tracer
+	uc := NewEventUseCase(validators, nil)
+	page, err := uc.QueryEvents(ctx, entities.EventQuery{Filter: filter}, valueobjects.PageRequest{Limit: 10, Direction: valueobjects.SortDescending})
+	if err != nil {
+		t.Fatalf("QueryEvents: %v", err)
+	}
+
+	if len(page.Items) != 10 || page.Info.Total != events || page.Items[0].Block != events {
+		t.Errorf("got %d events of %d starting at block %d, want 10 of %d starting at block %d",
+			len(page.Items), page.Info.Total, page.Items[0].Block, events, events)
+	}
+}
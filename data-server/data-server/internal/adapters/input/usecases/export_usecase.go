@@ -0,0 +1,111 @@
+package usecases
+
+import (
+	"context"
+	"sort"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+	"data-server/internal/ports/output"
+)
+
+// exportBatchSize is the number of events exported between two checks of the
+// cancellation of the export
+const exportBatchSize = 1000
+
+// ExportUseCase implements the ExportService interface
+type ExportUseCase struct {
+	validatorRepo output.ValidatorRepository
+}
+
+// NewExportUseCase creates a new export use case
+func NewExportUseCase(validatorRepo output.ValidatorRepository) *ExportUseCase {
+	return &ExportUseCase{
+		validatorRepo: validatorRepo,
+	}
+}
+
+// ExportEvents passes the events matching the query to write, ordered by block and event
+// index. The events are read from a single snapshot of the repository, so the export
+// is consistent and events saved meanwhile are left out. The snapshot is shared with
+// the in-memory repository, a repository backed by a database would have to be read
+// in batches instead
+func (uc *ExportUseCase) ExportEvents(ctx context.Context, query entities.EventQuery, write func(entities.ValidatorEvent) error) error {
+	if err := query.Validate(); err != nil {
+		return err
+	}
+
+	events, err := uc.validatorRepo.GetEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, event := range events {
+		// Check for a canceled export from time to time rather than on every event
+		if i%exportBatchSize == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+
+		matched, err := query.Matches(ctx, event.Stash, event.Event)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			continue
+		}
+		if err := write(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportValidators passes a summary of every validator to write, ordered by type
+func (uc *ExportUseCase) ExportValidators(ctx context.Context, write func(input.ValidatorSummary) error) error {
+	validators, err := uc.validatorRepo.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(validators, func(i, j int) bool {
+		return validators[i].Position().Compare(validators[j].Position()) < 0
+	})
+
+	for _, validator := range validators {
+		ledger := validator.StakeLedger()
+		summary := input.ValidatorSummary{
+			Stash:          validator.Stash,
+			Type:           string(validator.Type),
+			Description:    validator.Description,
+			TotalEvents:    len(validator.Events),
+			TotalRewards:   validator.GetTotalRewards(),
+			IsActive:       validator.IsActive(),
+			HasBeenSlashed: validator.HasBeenSlashed(),
+			StakeState:     ledger.State,
+			BondedStake:    ledger.Bonded,
+			LatestBlock:    validator.LatestBlock(),
+			UpdatedAt:      validator.UpdatedAt,
+		}
+		for _, event := range validator.Events {
+			switch event.GetEventCategory() {
+			case "staking":
+				summary.StakingEvents++
+			case "governance":
+				summary.GovernanceEvents++
+			case "online":
+				summary.OnlineEvents++
+			case "offence":
+				summary.OffenceEvents++
+			}
+		}
+
+		if err := write(summary); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+	"data-server/internal/ports/output"
+)
+
+// ExtrinsicUseCase implements the ExtrinsicService interface
+type ExtrinsicUseCase struct {
+	validatorRepo output.ValidatorRepository
+}
+
+// NewExtrinsicUseCase creates a new extrinsic use case
+func NewExtrinsicUseCase(validatorRepo output.ValidatorRepository) *ExtrinsicUseCase {
+	return &ExtrinsicUseCase{
+		validatorRepo: validatorRepo,
+	}
+}
+
+// GetExtrinsicFailures retrieves extrinsic failure analytics per account
+func (uc *ExtrinsicUseCase) GetExtrinsicFailures(ctx context.Context, filter input.ExtrinsicFailureFilter) (*entities.ExtrinsicFailureReport, error) {
+	validators, err := uc.validatorRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketSize := filter.BucketSize
+	if bucketSize <= 0 {
+		bucketSize = entities.DefaultExtrinsicTrendBucket
+	}
+
+	accounts := []entities.AccountExtrinsicFailures{}
+	for _, validator := range validators {
+		if filter.Validator != "" && filter.Validator != validator.Stash && filter.Validator != string(validator.Type) {
+			continue
+		}
+		accounts = append(accounts, validator.ExtrinsicFailures(filter.BlockRange, bucketSize))
+	}
+
+	return entities.NewExtrinsicFailureReport(accounts, bucketSize), nil
+}
@@ -0,0 +1,111 @@
+package usecases
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/output"
+)
+
+const (
+	// healthCheckTimeout bounds the duration of every health check, a check that takes
+	// longer fails
+	healthCheckTimeout = 2 * time.Second
+
+	// shutdownCheckName is the name of the check failing while the server shuts down
+	shutdownCheckName = "shutdown"
+)
+
+// registeredCheck represents a health checker registered under a name
+type registeredCheck struct {
+	name     string
+	checker  output.HealthChecker
+	critical bool
+}
+
+// HealthUseCase implements the HealthService interface. It keeps the registry of the
+// health checks, which are run concurrently on every request
+type HealthUseCase struct {
+	checks   []registeredCheck
+	draining atomic.Bool
+}
+
+// NewHealthUseCase creates a new health use case without checks
+func NewHealthUseCase() *HealthUseCase {
+	return &HealthUseCase{}
+}
+
+// Register adds a health check, which makes the server unhealthy when it fails if it is
+// critical and degraded otherwise. Checks must be registered before the server starts
+func (uc *HealthUseCase) Register(name string, checker output.HealthChecker, critical bool) {
+	uc.checks = append(uc.checks, registeredCheck{name: name, checker: checker, critical: critical})
+}
+
+// Drain makes the server unhealthy from now on, so that load balancers stop sending it
+// requests while it shuts down
+func (uc *HealthUseCase) Drain() {
+	uc.draining.Store(true)
+}
+
+// CheckHealth runs the registered health checks and reports the health of the server
+func (uc *HealthUseCase) CheckHealth(ctx context.Context) (*entities.HealthReport, error) {
+	checks := make([]entities.HealthCheck, len(uc.checks))
+
+	var wg sync.WaitGroup
+	for i, registered := range uc.checks {
+		wg.Add(1)
+		go func(i int, registered registeredCheck) {
+			defer wg.Done()
+			checks[i] = runCheck(ctx, registered)
+		}(i, registered)
+	}
+	wg.Wait()
+
+	if uc.draining.Load() {
+		checks = append(checks, entities.HealthCheck{
+			Name:     shutdownCheckName,
+			Status:   entities.HealthUnhealthy,
+			Critical: true,
+			Message:  entities.ErrShuttingDown.Error(),
+		})
+	}
+
+	return entities.NewHealthReport(checks, time.Now().UTC()), nil
+}
+
+// runCheck runs a health check within the health check timeout
+func runCheck(ctx context.Context, registered registeredCheck) entities.HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- registered.checker.CheckHealth(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	check := entities.HealthCheck{
+		Name:       registered.name,
+		Status:     entities.HealthHealthy,
+		Critical:   registered.critical,
+		DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		check.Status = entities.HealthDegraded
+		if registered.critical {
+			check.Status = entities.HealthUnhealthy
+		}
+		check.Message = err.Error()
+	}
+	return check
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+	"data-server/internal/ports/output"
+)
+
+// IncidentUseCase implements the IncidentService interface
+type IncidentUseCase struct {
+	validatorRepo output.ValidatorRepository
+}
+
+// NewIncidentUseCase creates a new incident use case
+func NewIncidentUseCase(validatorRepo output.ValidatorRepository) *IncidentUseCase {
+	return &IncidentUseCase{
+		validatorRepo: validatorRepo,
+	}
+}
+
+// GetIncidents retrieves the incidents of all validators at or above the given severity
+func (uc *IncidentUseCase) GetIncidents(ctx context.Context, minSeverity entities.IncidentSeverity, page valueobjects.PageRequest) (*input.Page[entities.Incident], error) {
+	validators, err := uc.validatorRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	incidents := []entities.Incident{}
+	for _, validator := range validators {
+		for _, incident := range validator.Incidents(entities.DefaultIncidentWindow) {
+			if minSeverity == "" || incident.AtLeast(minSeverity) {
+				incidents = append(incidents, incident)
+			}
+		}
+	}
+
+	return newPage(incidents, page)
+}
+
+// GetValidatorIncidents retrieves the incidents of a specific validator
+func (uc *IncidentUseCase) GetValidatorIncidents(ctx context.Context, validatorType string, page valueobjects.PageRequest) (*input.Page[entities.Incident], error) {
+	validator, err := uc.validatorRepo.GetByType(ctx, validatorType)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(validator.Incidents(entities.DefaultIncidentWindow), page)
+}
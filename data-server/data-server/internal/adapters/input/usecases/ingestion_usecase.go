@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/output"
+)
+
+// IngestionUseCase implements the IngestionService interface
+type IngestionUseCase struct {
+	validatorRepo   output.ValidatorRepository
+	chainHeadSource output.ChainHeadSource
+	maxLag          int
+}
+
+// NewIngestionUseCase creates a new ingestion use case. The chain head source is
+// optional, without it the chain head is unknown. The ingestion is unhealthy when it
+// trails the chain head by more than maxLag blocks, zero disables the lag check
+func NewIngestionUseCase(validatorRepo output.ValidatorRepository, chainHeadSource output.ChainHeadSource, maxLag int) *IngestionUseCase {
+	return &IngestionUseCase{
+		validatorRepo:   validatorRepo,
+		chainHeadSource: chainHeadSource,
+		maxLag:          maxLag,
+	}
+}
+
+// GetIngestionStatus retrieves the latest stored block and the chain head. A chain head
+// that cannot be read is logged and left unknown, so the stored data is still reported
+func (uc *IngestionUseCase) GetIngestionStatus(ctx context.Context) (*entities.IngestionStatus, error) {
+	version, err := uc.validatorRepo.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &entities.IngestionStatus{
+		LastStoredBlock: version.Block,
+		LastUpdatedAt:   version.UpdatedAt,
+	}
+	if uc.chainHeadSource == nil {
+		return status, nil
+	}
+
+	head, err := uc.chainHeadSource.ChainHead(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get chain head", "error", err)
+		return status, nil
+	}
+	status.ChainHead = &head
+	return status, nil
+}
+
+// CheckHealth returns an error if the chain head cannot be read, or if the stored data
+// trails it by more than the maximum lag. Without chain head source the ingestion is
+// always healthy
+func (uc *IngestionUseCase) CheckHealth(ctx context.Context) error {
+	if uc.chainHeadSource == nil {
+		return nil
+	}
+
+	version, err := uc.validatorRepo.Version(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := uc.chainHeadSource.ChainHead(ctx)
+	if err != nil {
+		return err
+	}
+
+	status := entities.IngestionStatus{LastStoredBlock: version.Block, ChainHead: &head}
+	if lag, _ := status.Lag(); uc.maxLag > 0 && lag > uc.maxLag {
+		return fmt.Errorf("%w: %d blocks behind the chain head, more than %d", entities.ErrIngestionLagging, lag, uc.maxLag)
+	}
+	return nil
+}
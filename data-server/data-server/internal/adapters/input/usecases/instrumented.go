@@ -0,0 +1,552 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+	"data-server/internal/ports/output"
+)
+
+// instrumentedValidatorService decorates a validator service, recording the
+// duration, outcome and span of every operation
+type instrumentedValidatorService struct {
+	next input.ValidatorService
+	operations
+}
+
+// NewInstrumentedValidatorService decorates a validator service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedValidatorService(next input.ValidatorService, metrics output.Metrics, tracer output.Tracer) input.ValidatorService {
+	return &instrumentedValidatorService{next: next, operations: newOperations("validator", "ValidatorService", metrics, tracer)}
+}
+
+// GetAllValidators retrieves all validators
+func (s *instrumentedValidatorService) GetAllValidators(ctx context.Context, page valueobjects.PageRequest) (_ *input.Page[*entities.Validator], err error) {
+	ctx, end := s.start(ctx, "GetAllValidators")
+	defer end(&err)
+	return s.next.GetAllValidators(ctx, page)
+}
+
+// GetValidatorByType retrieves a validator by its type
+func (s *instrumentedValidatorService) GetValidatorByType(ctx context.Context, validatorType string) (_ *entities.Validator, err error) {
+	ctx, end := s.start(ctx, "GetValidatorByType")
+	defer end(&err)
+	return s.next.GetValidatorByType(ctx, validatorType)
+}
+
+// GetValidatorEvents retrieves events for a specific validator
+func (s *instrumentedValidatorService) GetValidatorEvents(ctx context.Context, validatorType string, page valueobjects.PageRequest) (_ *input.Page[entities.Event], err error) {
+	ctx, end := s.start(ctx, "GetValidatorEvents")
+	defer end(&err)
+	return s.next.GetValidatorEvents(ctx, validatorType, page)
+}
+
+// GetValidatorEventsByType retrieves events of a specific type for a validator
+func (s *instrumentedValidatorService) GetValidatorEventsByType(ctx context.Context, validatorType, eventType string, page valueobjects.PageRequest) (_ *input.Page[entities.Event], err error) {
+	ctx, end := s.start(ctx, "GetValidatorEventsByType")
+	defer end(&err)
+	return s.next.GetValidatorEventsByType(ctx, validatorType, eventType, page)
+}
+
+// GetValidatorEventsByBlockRange retrieves events within a block range for a validator
+func (s *instrumentedValidatorService) GetValidatorEventsByBlockRange(ctx context.Context, validatorType string, startBlock, endBlock int, page valueobjects.PageRequest) (_ *input.Page[entities.Event], err error) {
+	ctx, end := s.start(ctx, "GetValidatorEventsByBlockRange")
+	defer end(&err)
+	return s.next.GetValidatorEventsByBlockRange(ctx, validatorType, startBlock, endBlock, page)
+}
+
+// QueryValidatorEvents retrieves events of a validator matching every criterion of the query
+func (s *instrumentedValidatorService) QueryValidatorEvents(ctx context.Context, validatorType string, query entities.EventQuery, page valueobjects.PageRequest) (_ *input.Page[entities.Event], err error) {
+	ctx, end := s.start(ctx, "QueryValidatorEvents")
+	defer end(&err)
+	return s.next.QueryValidatorEvents(ctx, validatorType, query, page)
+}
+
+// GetValidatorStats retrieves statistics for a validator
+func (s *instrumentedValidatorService) GetValidatorStats(ctx context.Context, validatorType string) (_ *input.ValidatorStats, err error) {
+	ctx, end := s.start(ctx, "GetValidatorStats")
+	defer end(&err)
+	return s.next.GetValidatorStats(ctx, validatorType)
+}
+
+// GetValidatorPayouts reconciles the era payouts of a validator
+func (s *instrumentedValidatorService) GetValidatorPayouts(ctx context.Context, validatorType string) (_ *entities.PayoutReport, err error) {
+	ctx, end := s.start(ctx, "GetValidatorPayouts")
+	defer end(&err)
+	return s.next.GetValidatorPayouts(ctx, validatorType)
+}
+
+// GetValidatorStake replays the bonded balance and lifecycle of a validator
+func (s *instrumentedValidatorService) GetValidatorStake(ctx context.Context, validatorType string) (_ *entities.StakeLedger, err error) {
+	ctx, end := s.start(ctx, "GetValidatorStake")
+	defer end(&err)
+	return s.next.GetValidatorStake(ctx, validatorType)
+}
+
+// GetDataVersion retrieves the version of the validator data, for caching responses derived from it
+func (s *instrumentedValidatorService) GetDataVersion(ctx context.Context) (_ *entities.DataVersion, err error) {
+	ctx, end := s.start(ctx, "GetDataVersion")
+	defer end(&err)
+	return s.next.GetDataVersion(ctx)
+}
+
+// instrumentedEventService decorates an event service, recording the
+// duration, outcome and span of every operation
+type instrumentedEventService struct {
+	next input.EventService
+	operations
+}
+
+// NewInstrumentedEventService decorates an event service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedEventService(next input.EventService, metrics output.Metrics, tracer output.Tracer) input.EventService {
+	return &instrumentedEventService{next: next, operations: newOperations("event", "EventService", metrics, tracer)}
+}
+
+// GetAllEvents retrieves all events
+func (s *instrumentedEventService) GetAllEvents(ctx context.Context, page valueobjects.PageRequest) (_ *input.Page[entities.Event], err error) {
+	ctx, end := s.start(ctx, "GetAllEvents")
+	defer end(&err)
+	return s.next.GetAllEvents(ctx, page)
+}
+
+// GetEventsByType retrieves events by event type
+func (s *instrumentedEventService) GetEventsByType(ctx context.Context, eventType string, page valueobjects.PageRequest) (_ *input.Page[entities.Event], err error) {
+	ctx, end := s.start(ctx, "GetEventsByType")
+	defer end(&err)
+	return s.next.GetEventsByType(ctx, eventType, page)
+}
+
+// GetEventsByBlockRange retrieves events within a block range
+func (s *instrumentedEventService) GetEventsByBlockRange(ctx context.Context, startBlock, endBlock int, page valueobjects.PageRequest) (_ *input.Page[entities.Event], err error) {
+	ctx, end := s.start(ctx, "GetEventsByBlockRange")
+	defer end(&err)
+	return s.next.GetEventsByBlockRange(ctx, startBlock, endBlock, page)
+}
+
+// GetEventsByCategory retrieves events by category (staking, governance, online, offence, extrinsic, consensus)
+func (s *instrumentedEventService) GetEventsByCategory(ctx context.Context, category string, page valueobjects.PageRequest) (_ *input.Page[entities.Event], err error) {
+	ctx, end := s.start(ctx, "GetEventsByCategory")
+	defer end(&err)
+	return s.next.GetEventsByCategory(ctx, category, page)
+}
+
+// GetEventsByValidator retrieves the events of a validator matching the query, failing
+// with entities.ErrValidatorNotFound if no validator has the given stash
+func (s *instrumentedEventService) GetEventsByValidator(ctx context.Context, stash string, query entities.EventQuery, page valueobjects.PageRequest) (_ *input.Page[entities.Event], err error) {
+	ctx, end := s.start(ctx, "GetEventsByValidator")
+	defer end(&err)
+	return s.next.GetEventsByValidator(ctx, stash, query, page)
+}
+
+// QueryEvents retrieves events matching every criterion of the query
+func (s *instrumentedEventService) QueryEvents(ctx context.Context, query entities.EventQuery, page valueobjects.PageRequest) (_ *input.Page[entities.Event], err error) {
+	ctx, end := s.start(ctx, "QueryEvents")
+	defer end(&err)
+	return s.next.QueryEvents(ctx, query, page)
+}
+
+// GetEventStats retrieves statistics about events
+func (s *instrumentedEventService) GetEventStats(ctx context.Context) (_ *input.EventStats, err error) {
+	ctx, end := s.start(ctx, "GetEventStats")
+	defer end(&err)
+	return s.next.GetEventStats(ctx)
+}
+
+// instrumentedIncidentService decorates an incident service, recording the
+// duration, outcome and span of every operation
+type instrumentedIncidentService struct {
+	next input.IncidentService
+	operations
+}
+
+// NewInstrumentedIncidentService decorates an incident service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedIncidentService(next input.IncidentService, metrics output.Metrics, tracer output.Tracer) input.IncidentService {
+	return &instrumentedIncidentService{next: next, operations: newOperations("incident", "IncidentService", metrics, tracer)}
+}
+
+// GetIncidents retrieves the incidents of all validators at or above the given severity
+func (s *instrumentedIncidentService) GetIncidents(ctx context.Context, minSeverity entities.IncidentSeverity, page valueobjects.PageRequest) (_ *input.Page[entities.Incident], err error) {
+	ctx, end := s.start(ctx, "GetIncidents")
+	defer end(&err)
+	return s.next.GetIncidents(ctx, minSeverity, page)
+}
+
+// GetValidatorIncidents retrieves the incidents of a specific validator
+func (s *instrumentedIncidentService) GetValidatorIncidents(ctx context.Context, validatorType string, page valueobjects.PageRequest) (_ *input.Page[entities.Incident], err error) {
+	ctx, end := s.start(ctx, "GetValidatorIncidents")
+	defer end(&err)
+	return s.next.GetValidatorIncidents(ctx, validatorType, page)
+}
+
+// instrumentedOffenceService decorates an offence service, recording the
+// duration, outcome and span of every operation
+type instrumentedOffenceService struct {
+	next input.OffenceService
+	operations
+}
+
+// NewInstrumentedOffenceService decorates an offence service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedOffenceService(next input.OffenceService, metrics output.Metrics, tracer output.Tracer) input.OffenceService {
+	return &instrumentedOffenceService{next: next, operations: newOperations("offence", "OffenceService", metrics, tracer)}
+}
+
+// GetOffenceReport retrieves offence analytics for the offences matching the filter
+func (s *instrumentedOffenceService) GetOffenceReport(ctx context.Context, filter input.OffenceFilter) (_ *entities.OffenceReport, err error) {
+	ctx, end := s.start(ctx, "GetOffenceReport")
+	defer end(&err)
+	return s.next.GetOffenceReport(ctx, filter)
+}
+
+// instrumentedExtrinsicService decorates an extrinsic service, recording the
+// duration, outcome and span of every operation
+type instrumentedExtrinsicService struct {
+	next input.ExtrinsicService
+	operations
+}
+
+// NewInstrumentedExtrinsicService decorates an extrinsic service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedExtrinsicService(next input.ExtrinsicService, metrics output.Metrics, tracer output.Tracer) input.ExtrinsicService {
+	return &instrumentedExtrinsicService{next: next, operations: newOperations("extrinsic", "ExtrinsicService", metrics, tracer)}
+}
+
+// GetExtrinsicFailures retrieves extrinsic failure analytics per account
+func (s *instrumentedExtrinsicService) GetExtrinsicFailures(ctx context.Context, filter input.ExtrinsicFailureFilter) (_ *entities.ExtrinsicFailureReport, err error) {
+	ctx, end := s.start(ctx, "GetExtrinsicFailures")
+	defer end(&err)
+	return s.next.GetExtrinsicFailures(ctx, filter)
+}
+
+// instrumentedEpochService decorates an epoch service, recording the
+// duration, outcome and span of every operation
+type instrumentedEpochService struct {
+	next input.EpochService
+	operations
+}
+
+// NewInstrumentedEpochService decorates an epoch service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedEpochService(next input.EpochService, metrics output.Metrics, tracer output.Tracer) input.EpochService {
+	return &instrumentedEpochService{next: next, operations: newOperations("epoch", "EpochService", metrics, tracer)}
+}
+
+// GetEpochs retrieves the BABE epochs ordered by index
+func (s *instrumentedEpochService) GetEpochs(ctx context.Context, page valueobjects.PageRequest) (_ *input.Page[entities.Epoch], err error) {
+	ctx, end := s.start(ctx, "GetEpochs")
+	defer end(&err)
+	return s.next.GetEpochs(ctx, page)
+}
+
+// instrumentedStreamService decorates a stream service, recording the
+// duration, outcome and span of every operation
+type instrumentedStreamService struct {
+	next input.StreamService
+	operations
+}
+
+// NewInstrumentedStreamService decorates a stream service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedStreamService(next input.StreamService, metrics output.Metrics, tracer output.Tracer) input.StreamService {
+	return &instrumentedStreamService{next: next, operations: newOperations("stream", "StreamService", metrics, tracer)}
+}
+
+// StreamEvents streams the events matching the query as they are saved. When after is
+// set, the stored events following that position are replayed first. The channel is
+// closed when the context is done or the subscriber falls too far behind, in which
+// case it can resume from the last received event
+func (s *instrumentedStreamService) StreamEvents(ctx context.Context, query entities.EventQuery, after *valueobjects.CursorKey) (_ <-chan entities.ValidatorEvent, err error) {
+	ctx, end := s.start(ctx, "StreamEvents")
+	defer end(&err)
+	return s.next.StreamEvents(ctx, query, after)
+}
+
+// instrumentedExportService decorates an export service, recording the
+// duration, outcome and span of every operation
+type instrumentedExportService struct {
+	next input.ExportService
+	operations
+}
+
+// NewInstrumentedExportService decorates an export service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedExportService(next input.ExportService, metrics output.Metrics, tracer output.Tracer) input.ExportService {
+	return &instrumentedExportService{next: next, operations: newOperations("export", "ExportService", metrics, tracer)}
+}
+
+// ExportEvents passes the events matching the query to write, ordered by block and
+// event index. It stops at the first error returned by write
+func (s *instrumentedExportService) ExportEvents(ctx context.Context, query entities.EventQuery, write func(entities.ValidatorEvent) error) (err error) {
+	ctx, end := s.start(ctx, "ExportEvents")
+	defer end(&err)
+	return s.next.ExportEvents(ctx, query, write)
+}
+
+// ExportValidators passes a summary of every validator to write, ordered by type
+func (s *instrumentedExportService) ExportValidators(ctx context.Context, write func(input.ValidatorSummary) error) (err error) {
+	ctx, end := s.start(ctx, "ExportValidators")
+	defer end(&err)
+	return s.next.ExportValidators(ctx, write)
+}
+
+// instrumentedWebhookService decorates a webhook service, recording the
+// duration, outcome and span of every operation
+type instrumentedWebhookService struct {
+	next input.WebhookService
+	operations
+}
+
+// NewInstrumentedWebhookService decorates a webhook service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedWebhookService(next input.WebhookService, metrics output.Metrics, tracer output.Tracer) input.WebhookService {
+	return &instrumentedWebhookService{next: next, operations: newOperations("webhook", "WebhookService", metrics, tracer)}
+}
+
+// CreateWebhook creates a webhook subscription, generating a secret if none is given
+func (s *instrumentedWebhookService) CreateWebhook(ctx context.Context, request input.WebhookRequest) (_ *entities.WebhookSubscription, err error) {
+	ctx, end := s.start(ctx, "CreateWebhook")
+	defer end(&err)
+	return s.next.CreateWebhook(ctx, request)
+}
+
+// GetWebhooks retrieves the webhook subscriptions
+func (s *instrumentedWebhookService) GetWebhooks(ctx context.Context, page valueobjects.PageRequest) (_ *input.Page[entities.WebhookSubscription], err error) {
+	ctx, end := s.start(ctx, "GetWebhooks")
+	defer end(&err)
+	return s.next.GetWebhooks(ctx, page)
+}
+
+// GetWebhook retrieves a webhook subscription
+func (s *instrumentedWebhookService) GetWebhook(ctx context.Context, id string) (_ *entities.WebhookSubscription, err error) {
+	ctx, end := s.start(ctx, "GetWebhook")
+	defer end(&err)
+	return s.next.GetWebhook(ctx, id)
+}
+
+// UpdateWebhook replaces the settings of a webhook subscription, enabling it again
+// resets its failure counter
+func (s *instrumentedWebhookService) UpdateWebhook(ctx context.Context, id string, request input.WebhookRequest) (_ *entities.WebhookSubscription, err error) {
+	ctx, end := s.start(ctx, "UpdateWebhook")
+	defer end(&err)
+	return s.next.UpdateWebhook(ctx, id, request)
+}
+
+// DeleteWebhook deletes a webhook subscription and its deliveries
+func (s *instrumentedWebhookService) DeleteWebhook(ctx context.Context, id string) (err error) {
+	ctx, end := s.start(ctx, "DeleteWebhook")
+	defer end(&err)
+	return s.next.DeleteWebhook(ctx, id)
+}
+
+// GetWebhookDeliveries retrieves the delivery log of a webhook subscription
+func (s *instrumentedWebhookService) GetWebhookDeliveries(ctx context.Context, id string, page valueobjects.PageRequest) (_ *input.Page[entities.WebhookDelivery], err error) {
+	ctx, end := s.start(ctx, "GetWebhookDeliveries")
+	defer end(&err)
+	return s.next.GetWebhookDeliveries(ctx, id, page)
+}
+
+// PingWebhook queues a test delivery for a webhook subscription
+func (s *instrumentedWebhookService) PingWebhook(ctx context.Context, id string) (_ *entities.WebhookDelivery, err error) {
+	ctx, end := s.start(ctx, "PingWebhook")
+	defer end(&err)
+	return s.next.PingWebhook(ctx, id)
+}
+
+// instrumentedAlertService decorates an alert service, recording the
+// duration, outcome and span of every operation
+type instrumentedAlertService struct {
+	next input.AlertService
+	operations
+}
+
+// NewInstrumentedAlertService decorates an alert service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedAlertService(next input.AlertService, metrics output.Metrics, tracer output.Tracer) input.AlertService {
+	return &instrumentedAlertService{next: next, operations: newOperations("alert", "AlertService", metrics, tracer)}
+}
+
+// GetAlerts retrieves the firing and resolved alerts matching the query
+func (s *instrumentedAlertService) GetAlerts(ctx context.Context, query entities.AlertQuery, page valueobjects.PageRequest) (_ *input.Page[entities.Alert], err error) {
+	ctx, end := s.start(ctx, "GetAlerts")
+	defer end(&err)
+	return s.next.GetAlerts(ctx, query, page)
+}
+
+// GetAlert retrieves an alert
+func (s *instrumentedAlertService) GetAlert(ctx context.Context, id string) (_ *entities.Alert, err error) {
+	ctx, end := s.start(ctx, "GetAlert")
+	defer end(&err)
+	return s.next.GetAlert(ctx, id)
+}
+
+// GetAlertRules retrieves the alert rules from the rules file and the API
+func (s *instrumentedAlertService) GetAlertRules(ctx context.Context, page valueobjects.PageRequest) (_ *input.Page[entities.AlertRule], err error) {
+	ctx, end := s.start(ctx, "GetAlertRules")
+	defer end(&err)
+	return s.next.GetAlertRules(ctx, page)
+}
+
+// GetAlertRule retrieves an alert rule
+func (s *instrumentedAlertService) GetAlertRule(ctx context.Context, id string) (_ *entities.AlertRule, err error) {
+	ctx, end := s.start(ctx, "GetAlertRule")
+	defer end(&err)
+	return s.next.GetAlertRule(ctx, id)
+}
+
+// CreateAlertRule creates an alert rule and evaluates it
+func (s *instrumentedAlertService) CreateAlertRule(ctx context.Context, request input.AlertRuleRequest) (_ *entities.AlertRule, err error) {
+	ctx, end := s.start(ctx, "CreateAlertRule")
+	defer end(&err)
+	return s.next.CreateAlertRule(ctx, request)
+}
+
+// UpdateAlertRule replaces an alert rule created through the API and evaluates it
+func (s *instrumentedAlertService) UpdateAlertRule(ctx context.Context, id string, request input.AlertRuleRequest) (_ *entities.AlertRule, err error) {
+	ctx, end := s.start(ctx, "UpdateAlertRule")
+	defer end(&err)
+	return s.next.UpdateAlertRule(ctx, id, request)
+}
+
+// DeleteAlertRule deletes an alert rule created through the API, resolving its alerts
+func (s *instrumentedAlertService) DeleteAlertRule(ctx context.Context, id string) (err error) {
+	ctx, end := s.start(ctx, "DeleteAlertRule")
+	defer end(&err)
+	return s.next.DeleteAlertRule(ctx, id)
+}
+
+// instrumentedAPIKeyService decorates an API key service, recording the
+// duration, outcome and span of every operation
+type instrumentedAPIKeyService struct {
+	next input.APIKeyService
+	operations
+}
+
+// NewInstrumentedAPIKeyService decorates an API key service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedAPIKeyService(next input.APIKeyService, metrics output.Metrics, tracer output.Tracer) input.APIKeyService {
+	return &instrumentedAPIKeyService{next: next, operations: newOperations("api_key", "APIKeyService", metrics, tracer)}
+}
+
+// Authenticate returns the principal of an API key secret, counting the request in
+// the usage of the key
+func (s *instrumentedAPIKeyService) Authenticate(ctx context.Context, secret string) (_ *entities.Principal, err error) {
+	ctx, end := s.start(ctx, "Authenticate")
+	defer end(&err)
+	return s.next.Authenticate(ctx, secret)
+}
+
+// CreateAPIKey creates an API key, the returned secret is not stored and cannot be
+// retrieved again
+func (s *instrumentedAPIKeyService) CreateAPIKey(ctx context.Context, request input.APIKeyRequest) (_ *input.CreatedAPIKey, err error) {
+	ctx, end := s.start(ctx, "CreateAPIKey")
+	defer end(&err)
+	return s.next.CreateAPIKey(ctx, request)
+}
+
+// GetAPIKeys retrieves the API keys
+func (s *instrumentedAPIKeyService) GetAPIKeys(ctx context.Context, page valueobjects.PageRequest) (_ *input.Page[entities.APIKey], err error) {
+	ctx, end := s.start(ctx, "GetAPIKeys")
+	defer end(&err)
+	return s.next.GetAPIKeys(ctx, page)
+}
+
+// GetAPIKey retrieves an API key
+func (s *instrumentedAPIKeyService) GetAPIKey(ctx context.Context, id string) (_ *entities.APIKey, err error) {
+	ctx, end := s.start(ctx, "GetAPIKey")
+	defer end(&err)
+	return s.next.GetAPIKey(ctx, id)
+}
+
+// UpdateAPIKey replaces the settings of an API key, keeping its secret
+func (s *instrumentedAPIKeyService) UpdateAPIKey(ctx context.Context, id string, request input.APIKeyRequest) (_ *entities.APIKey, err error) {
+	ctx, end := s.start(ctx, "UpdateAPIKey")
+	defer end(&err)
+	return s.next.UpdateAPIKey(ctx, id, request)
+}
+
+// DeleteAPIKey revokes an API key
+func (s *instrumentedAPIKeyService) DeleteAPIKey(ctx context.Context, id string) (err error) {
+	ctx, end := s.start(ctx, "DeleteAPIKey")
+	defer end(&err)
+	return s.next.DeleteAPIKey(ctx, id)
+}
+
+// instrumentedIngestionService decorates an ingestion service, recording the
+// duration, outcome and span of every operation
+type instrumentedIngestionService struct {
+	next input.IngestionService
+	operations
+}
+
+// NewInstrumentedIngestionService decorates an ingestion service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedIngestionService(next input.IngestionService, metrics output.Metrics, tracer output.Tracer) input.IngestionService {
+	return &instrumentedIngestionService{next: next, operations: newOperations("ingestion", "IngestionService", metrics, tracer)}
+}
+
+// GetIngestionStatus retrieves the latest stored block and, when the chain head is
+// known, how far the stored data trails it
+func (s *instrumentedIngestionService) GetIngestionStatus(ctx context.Context) (_ *entities.IngestionStatus, err error) {
+	ctx, end := s.start(ctx, "GetIngestionStatus")
+	defer end(&err)
+	return s.next.GetIngestionStatus(ctx)
+}
+
+// instrumentedHealthService decorates a health service, recording the duration,
+// outcome and span of every operation
+type instrumentedHealthService struct {
+	next input.HealthService
+	operations
+}
+
+// NewInstrumentedHealthService decorates a health service with the recording of the
+// duration, outcome and span of its operations
+func NewInstrumentedHealthService(next input.HealthService, metrics output.Metrics, tracer output.Tracer) input.HealthService {
+	return &instrumentedHealthService{next: next, operations: newOperations("health", "HealthService", metrics, tracer)}
+}
+
+// CheckHealth runs the registered health checks and reports the health of the server
+func (s *instrumentedHealthService) CheckHealth(ctx context.Context) (_ *entities.HealthReport, err error) {
+	ctx, end := s.start(ctx, "CheckHealth")
+	defer end(&err)
+	return s.next.CheckHealth(ctx)
+}
+
+// operations records the duration and outcome of the operations of a use case, and
+// traces each of them in a span
+type operations struct {
+	useCase    string
+	spanPrefix string
+	metrics    output.Metrics
+	tracer     output.Tracer
+}
+
+// newOperations creates a new operation recorder of a use case
+func newOperations(useCase, spanPrefix string, metrics output.Metrics, tracer output.Tracer) operations {
+	return operations{
+		useCase:    useCase,
+		spanPrefix: spanPrefix,
+		metrics:    metrics,
+		tracer:     tracer,
+	}
+}
+
+// start starts an operation, returning a copy of the context carrying its span and the
+// function ending it with its error
+func (o operations) start(ctx context.Context, operation string) (context.Context, func(*error)) {
+	start := time.Now()
+	ctx, span := o.tracer.Start(ctx, o.spanPrefix+"."+operation, map[string]string{
+		"use_case":  o.useCase,
+		"operation": operation,
+	})
+
+	return ctx, func(err *error) {
+		o.metrics.ObserveUseCaseOperation(o.useCase, operation, time.Since(start), *err)
+		span.End(*err)
+	}
+}
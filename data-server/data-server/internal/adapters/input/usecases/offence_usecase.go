@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+	"data-server/internal/ports/output"
+)
+
+// OffenceUseCase implements the OffenceService interface
+type OffenceUseCase struct {
+	validatorRepo output.ValidatorRepository
+}
+
+// NewOffenceUseCase creates a new offence use case
+func NewOffenceUseCase(validatorRepo output.ValidatorRepository) *OffenceUseCase {
+	return &OffenceUseCase{
+		validatorRepo: validatorRepo,
+	}
+}
+
+// GetOffenceReport retrieves offence analytics for the offences matching the filter
+func (uc *OffenceUseCase) GetOffenceReport(ctx context.Context, filter input.OffenceFilter) (*entities.OffenceReport, error) {
+	validators, err := uc.validatorRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []entities.Event
+	for _, validator := range validators {
+		events = append(events, validator.Events...)
+	}
+
+	offender := filter.Validator
+	if offender != "" {
+		if validator, err := uc.validatorRepo.GetByType(ctx, offender); err == nil {
+			offender = validator.Stash
+		}
+	}
+
+	var offences []entities.Offence
+	for _, offence := range entities.ExtractOffences(events, entities.NewSessionTimeline(events)) {
+		if filter.Kind != "" && offence.Kind != filter.Kind {
+			continue
+		}
+		if offender != "" && offence.Offender != offender {
+			continue
+		}
+		if filter.BlockRange != nil && !filter.BlockRange.Contains(offence.Block) {
+			continue
+		}
+		offences = append(offences, offence)
+	}
+	if offences == nil {
+		offences = []entities.Offence{}
+	}
+
+	return entities.AnalyzeOffences(offences, entities.DefaultRepeatOffenceWindow), nil
+}
@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+)
+
+// positioned is implemented by items with a stable position in a list
+type positioned interface {
+	Position() valueobjects.CursorKey
+}
+
+// newPage returns the requested page of items ordered by their position
+func newPage[T positioned](items []T, page valueobjects.PageRequest) (*input.Page[T], error) {
+	selected, info, err := valueobjects.Paginate(items, T.Position, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return pageOf(selected, info), nil
+}
+
+// pageOf returns a page of items selected by a repository
+func pageOf[T any](items []T, info valueobjects.PageInfo) *input.Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+
+	return &input.Page[T]{
+		Items: items,
+		Info:  info,
+	}
+}
@@ -0,0 +1,158 @@
+package usecases
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/output"
+)
+
+// streamBuffer is the number of live events a stream may have pending before it is
+// considered too far behind
+const streamBuffer = 256
+
+// streamedEvent identifies an event replayed to a stream
+type streamedEvent struct {
+	stash    string
+	position valueobjects.CursorKey
+}
+
+// StreamUseCase implements the StreamService interface
+type StreamUseCase struct {
+	validatorRepo output.ValidatorRepository
+	eventBus      output.EventBus
+	closing       chan struct{}
+	closeOnce     sync.Once
+}
+
+// NewStreamUseCase creates a new stream use case
+func NewStreamUseCase(validatorRepo output.ValidatorRepository, eventBus output.EventBus) *StreamUseCase {
+	return &StreamUseCase{
+		validatorRepo: validatorRepo,
+		eventBus:      eventBus,
+		closing:       make(chan struct{}),
+	}
+}
+
+// Close ends the open streams and the streams opened from now on, so that the server can
+// shut down without waiting for their clients to disconnect
+func (uc *StreamUseCase) Close() {
+	uc.closeOnce.Do(func() { close(uc.closing) })
+}
+
+// StreamEvents streams the events matching the query, replaying the stored events after
+// the given position before switching to live events
+func (uc *StreamUseCase) StreamEvents(ctx context.Context, query entities.EventQuery, after *valueobjects.CursorKey) (<-chan entities.ValidatorEvent, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Subscribe before reading the backlog so no event saved in between is missed
+	subscription := uc.eventBus.Subscribe(streamBuffer)
+
+	var backlog []entities.ValidatorEvent
+	if after != nil {
+		var err error
+		if backlog, err = uc.backlog(ctx, query, *after); err != nil {
+			subscription.Cancel()
+			return nil, err
+		}
+	}
+
+	events := make(chan entities.ValidatorEvent)
+	go func() {
+		defer close(events)
+		defer subscription.Cancel()
+
+		// Only the events actually sent from the backlog are skipped when they come in live:
+		// live events saved after the backlog was read are streamed whatever their position
+		replayed := make(map[streamedEvent]bool, len(backlog))
+		for _, event := range backlog {
+			replayed[streamedEvent{stash: event.Stash, position: event.Position()}] = true
+		}
+
+		send := func(event entities.ValidatorEvent) bool {
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			case <-uc.closing:
+				return false
+			}
+		}
+
+		for _, event := range backlog {
+			if !send(event) {
+				return
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-uc.closing:
+				return
+			case event, ok := <-subscription.Events():
+				if !ok {
+					return
+				}
+				if replayed[streamedEvent{stash: event.Stash, position: event.Position()}] {
+					continue
+				}
+				matched, err := query.Matches(ctx, event.Stash, event.Event)
+				if err != nil {
+					// The stream cannot tell its client which events it left out, so it ends
+					if ctx.Err() == nil {
+						slog.WarnContext(ctx, "Ending event stream, the filter failed on a live event", "validator", event.Stash, "block", event.Block, "error", err)
+					}
+					return
+				}
+				if !matched {
+					continue
+				}
+				if !send(event) {
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// backlog returns the stored events matching the query after the given position,
+// ordered by block and event index
+func (uc *StreamUseCase) backlog(ctx context.Context, query entities.EventQuery, after valueobjects.CursorKey) ([]entities.ValidatorEvent, error) {
+	validators, err := uc.validatorRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var backlog []entities.ValidatorEvent
+	for _, v := range validators {
+		for _, e := range v.Events {
+			if e.Position().Compare(after) <= 0 {
+				continue
+			}
+			matched, err := query.Matches(ctx, v.Stash, e)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				backlog = append(backlog, entities.ValidatorEvent{Stash: v.Stash, Event: e})
+			}
+		}
+	}
+
+	sort.SliceStable(backlog, func(i, j int) bool {
+		return backlog[i].Position().Compare(backlog[j].Position()) < 0
+	})
+
+	return backlog, nil
+}
@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"data-server/internal/adapters/output/eventbus"
+	"data-server/internal/adapters/output/memory"
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/expression"
+	"data-server/internal/domain/valueobjects"
+)
+
+// nextStreamedEvent returns the identifier of the next event of a stream
+func nextStreamedEvent(t *testing.T, events <-chan entities.ValidatorEvent) string {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("stream closed")
+		}
+		return event.ID()
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a streamed event")
+		return ""
+	}
+}
+
+func TestStreamEventsKeepsLiveEventsSavedOutOfOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	validators := memory.NewEmptyValidatorRepository()
+	good := entities.NewValidator("5F3sa2TJc...Good", entities.ValidatorTypeGood, "")
+	bad := entities.NewValidator("5HGjWAeFD...Bad", entities.ValidatorTypeBad, "")
+	good.AddEvent(*entities.NewEvent(100, "staking.Rewarded", map[string]interface{}{"amount": 1}))
+	good.AddEvent(*entities.NewEvent(120, "staking.Rewarded", map[string]interface{}{"amount": 2}))
+	if err := validators.Save(ctx, good); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	bus := eventbus.NewBus()
+	publishing, err := eventbus.NewPublishingRepository(validators, bus)
+	if err != nil {
+		t.Fatalf("NewPublishingRepository: %v", err)
+	}
+	uc := NewStreamUseCase(validators, bus)
+
+	// The stream resumes after block 100, replaying block 120
+	events, err := uc.StreamEvents(ctx, entities.EventQuery{}, &valueobjects.CursorKey{Block: 100})
+	if err != nil {
+		t.Fatalf("StreamEvents: %v", err)
+	}
+	if got := nextStreamedEvent(t, events); got != "120-0" {
+		t.Fatalf("replayed event = %s, want 120-0", got)
+	}
+
+	// A live event of a validator is followed by an earlier one of another validator,
+	// both after the replay
+	good.AddEvent(*entities.NewEvent(200, "staking.Slashed", map[string]interface{}{"amount": 3}))
+	if err := publishing.Save(ctx, good); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	bad.AddEvent(*entities.NewEvent(150, "im_online.SomeOffline", map[string]interface{}{}))
+	if err := publishing.Save(ctx, bad); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// An event saved after the replay with a position before the replayed one was never
+	// sent, so it is streamed too
+	bad.AddEvent(*entities.NewEvent(110, "im_online.SomeOffline", map[string]interface{}{}))
+	if err := publishing.Save(ctx, bad); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	for _, want := range []string{"200-0", "150-0", "110-0"} {
+		if got := nextStreamedEvent(t, events); got != want {
+			t.Errorf("live event = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestStreamEventsEndsWhenTheFilterFailsOnALiveEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	validators := memory.NewEmptyValidatorRepository()
+	bus := eventbus.NewBus()
+	publishing, err := eventbus.NewPublishingRepository(validators, bus)
+	if err != nil {
+		t.Fatalf("NewPublishingRepository: %v", err)
+	}
+
+	filter, err := expression.CompileWithCostLimit(`data.tags.exists(t, t == "payout")`, 1)
+	if err != nil {
+		t.Fatalf("CompileWithCostLimit: %v", err)
+	}
+	events, err := NewStreamUseCase(validators, bus).StreamEvents(ctx, entities.EventQuery{Filter: filter}, nil)
+	if err != nil {
+		t.Fatalf("StreamEvents: %v", err)
+	}
+
+	good := entities.NewValidator("5F3sa2TJc...Good", entities.ValidatorTypeGood, "")
+	good.AddEvent(*entities.NewEvent(100, "staking.Rewarded", map[string]interface{}{"tags": []interface{}{"payout"}}))
+	if err := publishing.Save(ctx, good); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	select {
+	case event, ok := <-events:
+		if ok {
+			t.Fatalf("streamed %s, want the stream to end", event.ID())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the stream to end")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
 	"data-server/internal/ports/input"
 	"data-server/internal/ports/output"
 )
@@ -21,8 +22,13 @@ func NewValidatorUseCase(validatorRepo output.ValidatorRepository) *ValidatorUse
 }
 
 // GetAllValidators retrieves all validators
-func (uc *ValidatorUseCase) GetAllValidators(ctx context.Context) ([]*entities.Validator, error) {
-	return uc.validatorRepo.GetAll(ctx)
+func (uc *ValidatorUseCase) GetAllValidators(ctx context.Context, page valueobjects.PageRequest) (*input.Page[*entities.Validator], error) {
+	validators, info, err := uc.validatorRepo.GetPage(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return pageOf(validators, info), nil
 }
 
 // GetValidatorByType retrieves a validator by its type
@@ -31,33 +37,46 @@ func (uc *ValidatorUseCase) GetValidatorByType(ctx context.Context, validatorTyp
 }
 
 // GetValidatorEvents retrieves events for a specific validator
-func (uc *ValidatorUseCase) GetValidatorEvents(ctx context.Context, validatorType string) ([]entities.Event, error) {
-	validator, err := uc.validatorRepo.GetByType(ctx, validatorType)
+func (uc *ValidatorUseCase) GetValidatorEvents(ctx context.Context, validatorType string, page valueobjects.PageRequest) (*input.Page[entities.Event], error) {
+	return uc.QueryValidatorEvents(ctx, validatorType, entities.EventQuery{}, page)
+}
+
+// GetValidatorEventsByType retrieves events of a specific type for a validator
+func (uc *ValidatorUseCase) GetValidatorEventsByType(ctx context.Context, validatorType, eventType string, page valueobjects.PageRequest) (*input.Page[entities.Event], error) {
+	return uc.QueryValidatorEvents(ctx, validatorType, entities.EventQuery{Types: []string{eventType}}, page)
+}
+
+// GetValidatorEventsByBlockRange retrieves events within a block range for a validator
+func (uc *ValidatorUseCase) GetValidatorEventsByBlockRange(ctx context.Context, validatorType string, startBlock, endBlock int, page valueobjects.PageRequest) (*input.Page[entities.Event], error) {
+	blockRange, err := valueobjects.NewBlockRange(startBlock, endBlock)
 	if err != nil {
 		return nil, err
 	}
-	
-	return validator.Events, nil
+	return uc.QueryValidatorEvents(ctx, validatorType, entities.EventQuery{BlockRange: blockRange}, page)
 }
 
-// GetValidatorEventsByType retrieves events of a specific type for a validator
-func (uc *ValidatorUseCase) GetValidatorEventsByType(ctx context.Context, validatorType, eventType string) ([]entities.Event, error) {
+// QueryValidatorEvents retrieves events of a validator matching every criterion of the query
+func (uc *ValidatorUseCase) QueryValidatorEvents(ctx context.Context, validatorType string, query entities.EventQuery, page valueobjects.PageRequest) (*input.Page[entities.Event], error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
 	validator, err := uc.validatorRepo.GetByType(ctx, validatorType)
 	if err != nil {
 		return nil, err
 	}
-	
-	return validator.GetEventsByType(eventType), nil
-}
 
-// GetValidatorEventsByBlockRange retrieves events within a block range for a validator
-func (uc *ValidatorUseCase) GetValidatorEventsByBlockRange(ctx context.Context, validatorType string, startBlock, endBlock int) ([]entities.Event, error) {
-	validator, err := uc.validatorRepo.GetByType(ctx, validatorType)
+	if !query.MatchesStash(validator.Stash) {
+		return newPage([]entities.Event{}, page)
+	}
+	query.Stashes = []string{validator.Stash}
+
+	events, info, err := uc.validatorRepo.QueryEvents(ctx, query, page)
 	if err != nil {
 		return nil, err
 	}
-	
-	return validator.GetEventsByBlockRange(startBlock, endBlock), nil
+
+	return pageOf(events, info), nil
 }
 
 // GetValidatorStats retrieves statistics for a validator
@@ -67,6 +86,8 @@ func (uc *ValidatorUseCase) GetValidatorStats(ctx context.Context, validatorType
 		return nil, err
 	}
 	
+	ledger := validator.StakeLedger()
+	
 	stats := &input.ValidatorStats{
 		TotalEvents:     len(validator.Events),
 		StakingEvents:   0,
@@ -76,6 +97,11 @@ func (uc *ValidatorUseCase) GetValidatorStats(ctx context.Context, validatorType
 		TotalRewards:    validator.GetTotalRewards(),
 		IsActive:        validator.IsActive(),
 		HasBeenSlashed:  validator.HasBeenSlashed(),
+		Payouts:         validator.ReconcilePayouts(entities.DefaultPayoutLateThreshold).Summary,
+		StakeState:      ledger.State,
+		BondedStake:     ledger.Bonded,
+		OffencesByKind:  validator.GetOffencesByKind(),
+		Consensus:       validator.ConsensusParticipation(),
 	}
 	
 	// Count events by category
@@ -93,4 +119,29 @@ func (uc *ValidatorUseCase) GetValidatorStats(ctx context.Context, validatorType
 	}
 	
 	return stats, nil
-} 
\ No newline at end of file
+}
+
+// GetValidatorPayouts reconciles the era payouts of a validator
+func (uc *ValidatorUseCase) GetValidatorPayouts(ctx context.Context, validatorType string) (*entities.PayoutReport, error) {
+	validator, err := uc.validatorRepo.GetByType(ctx, validatorType)
+	if err != nil {
+		return nil, err
+	}
+
+	return validator.ReconcilePayouts(entities.DefaultPayoutLateThreshold), nil
+}
+
+// GetValidatorStake replays the bonded balance and lifecycle of a validator
+func (uc *ValidatorUseCase) GetValidatorStake(ctx context.Context, validatorType string) (*entities.StakeLedger, error) {
+	validator, err := uc.validatorRepo.GetByType(ctx, validatorType)
+	if err != nil {
+		return nil, err
+	}
+
+	return validator.StakeLedger(), nil
+}
+
+// GetDataVersion retrieves the version of the validator data
+func (uc *ValidatorUseCase) GetDataVersion(ctx context.Context) (*entities.DataVersion, error) {
+	return uc.validatorRepo.Version(ctx)
+}
@@ -0,0 +1,459 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/input"
+	"data-server/internal/ports/output"
+	"data-server/pkg/logger"
+)
+
+const (
+	// webhookDispatchInterval is how often the outbox is checked for due deliveries
+	webhookDispatchInterval = time.Second
+
+	// webhookDispatchBatch is the maximum number of deliveries attempted per dispatch
+	webhookDispatchBatch = 50
+
+	// webhookBusBuffer is the number of events the dispatcher may have pending on the bus
+	webhookBusBuffer = 1024
+)
+
+// webhookPayload represents the body posted to webhook subscribers
+type webhookPayload struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// WebhookUseCase implements the WebhookService interface and dispatches the queued
+// deliveries to the subscribers
+type WebhookUseCase struct {
+	webhookRepo   output.WebhookRepository
+	outbox        output.WebhookOutbox
+	sender        output.WebhookSender
+	eventBus      output.EventBus
+	validatorRepo output.ValidatorRepository
+	mutex         sync.Mutex
+}
+
+// NewWebhookUseCase creates a new webhook use case, replaying the events of the validator
+// repository that the dispatcher missed on the bus
+func NewWebhookUseCase(webhookRepo output.WebhookRepository, outbox output.WebhookOutbox, sender output.WebhookSender, eventBus output.EventBus, validatorRepo output.ValidatorRepository) *WebhookUseCase {
+	return &WebhookUseCase{
+		webhookRepo:   webhookRepo,
+		outbox:        outbox,
+		sender:        sender,
+		eventBus:      eventBus,
+		validatorRepo: validatorRepo,
+	}
+}
+
+// CreateWebhook creates a webhook subscription, generating a secret if none is given.
+// The secret is only returned by this call
+func (uc *WebhookUseCase) CreateWebhook(ctx context.Context, request input.WebhookRequest) (*entities.WebhookSubscription, error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	subscription := entities.WebhookSubscription{
+		ID:          "wh_" + randomHex(12),
+		URL:         request.URL,
+		Secret:      request.Secret,
+		Description: request.Description,
+		Filter:      request.Filter,
+		Enabled:     request.Enabled == nil || *request.Enabled,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if subscription.Secret == "" {
+		subscription.Secret = "whsec_" + randomHex(24)
+	}
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	if err := uc.webhookRepo.Save(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+// GetWebhooks retrieves the webhook subscriptions
+func (uc *WebhookUseCase) GetWebhooks(ctx context.Context, page valueobjects.PageRequest) (*input.Page[entities.WebhookSubscription], error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := uc.webhookRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range subscriptions {
+		subscriptions[i].Secret = ""
+	}
+
+	return newPage(subscriptions, page)
+}
+
+// GetWebhook retrieves a webhook subscription
+func (uc *WebhookUseCase) GetWebhook(ctx context.Context, id string) (*entities.WebhookSubscription, error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	subscription, err := uc.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Secret = ""
+	return subscription, nil
+}
+
+// UpdateWebhook replaces the settings of a webhook subscription, keeping its secret when
+// none is given. Enabling it again resets its failure counter
+func (uc *WebhookUseCase) UpdateWebhook(ctx context.Context, id string, request input.WebhookRequest) (*entities.WebhookSubscription, error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	subscription, err := uc.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.URL = request.URL
+	subscription.Description = request.Description
+	subscription.Filter = request.Filter
+	if request.Secret != "" {
+		subscription.Secret = request.Secret
+	}
+	if request.Enabled != nil {
+		if *request.Enabled && !subscription.Enabled {
+			subscription.ConsecutiveFailures = 0
+			subscription.DisabledReason = ""
+		}
+		subscription.Enabled = *request.Enabled
+	}
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+
+	subscription.UpdatedAt = time.Now().UTC()
+	if err := uc.webhookRepo.Save(ctx, *subscription); err != nil {
+		return nil, err
+	}
+
+	subscription.Secret = ""
+	return subscription, nil
+}
+
+// DeleteWebhook deletes a webhook subscription and its deliveries
+func (uc *WebhookUseCase) DeleteWebhook(ctx context.Context, id string) error {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return err
+	}
+
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	return uc.webhookRepo.Delete(ctx, id)
+}
+
+// GetWebhookDeliveries retrieves the delivery log of a webhook subscription
+func (uc *WebhookUseCase) GetWebhookDeliveries(ctx context.Context, id string, page valueobjects.PageRequest) (*input.Page[entities.WebhookDelivery], error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.webhookRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := uc.outbox.GetBySubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(deliveries, page)
+}
+
+// PingWebhook queues a test delivery for a webhook subscription, sent even if the
+// subscription is disabled
+func (uc *WebhookUseCase) PingWebhook(ctx context.Context, id string) (*entities.WebhookDelivery, error) {
+	if err := entities.RequireScope(ctx, entities.ScopeAdmin); err != nil {
+		return nil, err
+	}
+
+	subscription, err := uc.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := newWebhookDelivery(subscription.ID, "ping_"+randomHex(8), entities.WebhookPingEvent, map[string]string{
+		"webhook_id": subscription.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	deliveries := []entities.WebhookDelivery{delivery}
+	if err := uc.outbox.Enqueue(ctx, deliveries...); err != nil {
+		return nil, err
+	}
+
+	return &deliveries[0], nil
+}
+
+// Run queues the events published on the bus for the matching subscriptions and delivers
+// the due deliveries of the outbox until the context is canceled. Deliveries are sent
+// apart from the bus, so slow subscribers do not hold back the queueing of new events
+func (uc *WebhookUseCase) Run(ctx context.Context) {
+	ctx = logger.With(ctx, slog.String("worker", "webhook_dispatcher"))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		uc.runDispatch(ctx)
+	}()
+	defer wg.Wait()
+
+	uc.runQueue(ctx)
+}
+
+// runQueue queues the events published on the bus until the context is canceled. When
+// the bus drops the dispatcher because it fell behind, the stored events after the last
+// queued one are replayed, so no event published in the meantime is lost
+func (uc *WebhookUseCase) runQueue(ctx context.Context) {
+	// Subscribe before reading the last stored event so no event saved in between is missed
+	subscription := uc.eventBus.Subscribe(webhookBusBuffer)
+	defer func() { subscription.Cancel() }()
+
+	var last valueobjects.CursorKey
+	if events, err := uc.validatorRepo.GetEvents(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to read the last stored event", "error", err)
+	} else if len(events) > 0 {
+		last = events[len(events)-1].Position()
+	}
+
+	// replayed is the last position queued by the replay, the live events up to it were
+	// queued already
+	var replayed *valueobjects.CursorKey
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-subscription.Events():
+			if !ok {
+				slog.WarnContext(ctx, "Webhook dispatcher fell behind the event bus, resubscribing and replaying the stored events")
+				subscription = uc.eventBus.Subscribe(webhookBusBuffer)
+				if replayed = uc.replay(ctx, last); replayed != nil {
+					last = *replayed
+				}
+				continue
+			}
+			position := event.Position()
+			if replayed != nil && position.Compare(*replayed) <= 0 {
+				continue
+			}
+			if err := uc.enqueue(ctx, event); err != nil {
+				slog.ErrorContext(ctx, "Failed to queue webhook deliveries", "event", event.ID(), "validator", event.Stash, "block", event.Event.Block, "error", err)
+			}
+			if position.Compare(last) > 0 {
+				last = position
+			}
+		}
+	}
+}
+
+// replay queues the stored events after the given position, ordered by block and event
+// index, and returns the position of the last one, nil if there was none
+func (uc *WebhookUseCase) replay(ctx context.Context, after valueobjects.CursorKey) *valueobjects.CursorKey {
+	events, err := uc.validatorRepo.GetEvents(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to replay the stored events", "after_block", after.Block, "error", err)
+		return nil
+	}
+
+	var replayed *valueobjects.CursorKey
+	for _, event := range events {
+		position := event.Position()
+		if position.Compare(after) <= 0 {
+			continue
+		}
+		if err := uc.enqueue(ctx, event); err != nil {
+			slog.ErrorContext(ctx, "Failed to queue webhook deliveries", "event", event.ID(), "validator", event.Stash, "block", event.Event.Block, "error", err)
+		}
+		replayed = &position
+	}
+
+	return replayed
+}
+
+// runDispatch delivers the due deliveries of the outbox until the context is canceled
+func (uc *WebhookUseCase) runDispatch(ctx context.Context) {
+	ticker := time.NewTicker(webhookDispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.dispatch(ctx)
+		}
+	}
+}
+
+// enqueue queues a delivery of the event for every enabled subscription it matches
+func (uc *WebhookUseCase) enqueue(ctx context.Context, event entities.ValidatorEvent) error {
+	subscriptions, err := uc.webhookRepo.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	var deliveries []entities.WebhookDelivery
+	for _, subscription := range subscriptions {
+		if !subscription.Enabled || !subscription.Filter.Matches(event) {
+			continue
+		}
+		delivery, err := newWebhookDelivery(subscription.ID, event.ID(), event.Event.Event, event)
+		if err != nil {
+			return err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return uc.outbox.Enqueue(ctx, deliveries...)
+}
+
+// dispatch attempts the due deliveries concurrently, one at a time per subscription so
+// that their failures are counted in order
+func (uc *WebhookUseCase) dispatch(ctx context.Context) {
+	due, err := uc.outbox.GetDue(ctx, time.Now().UTC(), webhookDispatchBatch)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to read webhook outbox", "error", err)
+		return
+	}
+
+	bySubscription := map[string][]entities.WebhookDelivery{}
+	for _, delivery := range due {
+		bySubscription[delivery.SubscriptionID] = append(bySubscription[delivery.SubscriptionID], delivery)
+	}
+
+	var wg sync.WaitGroup
+	for _, deliveries := range bySubscription {
+		wg.Add(1)
+		go func(deliveries []entities.WebhookDelivery) {
+			defer wg.Done()
+			for _, delivery := range deliveries {
+				if err := uc.deliver(ctx, delivery); err != nil {
+					slog.ErrorContext(ctx, "Failed to record webhook delivery", "delivery", delivery.ID, "subscription", delivery.SubscriptionID, "error", err)
+				}
+			}
+		}(deliveries)
+	}
+	wg.Wait()
+}
+
+// deliver sends a delivery to its subscriber and records the attempt. Deliveries of
+// deleted or disabled subscriptions are canceled, except pings
+func (uc *WebhookUseCase) deliver(ctx context.Context, delivery entities.WebhookDelivery) error {
+	subscription, err := uc.webhookRepo.GetByID(ctx, delivery.SubscriptionID)
+	if err != nil || (!subscription.Enabled && delivery.EventType != entities.WebhookPingEvent) {
+		delivery.Cancel(time.Now().UTC())
+		return uc.outbox.SaveDelivery(ctx, delivery)
+	}
+
+	timestamp := time.Now().UTC()
+	headers := map[string]string{
+		"X-Webhook-Id":        delivery.ID,
+		"X-Webhook-Event":     delivery.EventType,
+		"X-Webhook-Timestamp": strconv.FormatInt(timestamp.Unix(), 10),
+		"X-Webhook-Signature": "sha256=" + entities.SignWebhookPayload(subscription.Secret, timestamp, delivery.Payload),
+	}
+
+	statusCode, sendErr := uc.sender.Send(ctx, subscription.URL, headers, delivery.Payload)
+	attempt := entities.WebhookAttempt{
+		At:         timestamp,
+		StatusCode: statusCode,
+		DurationMs: time.Since(timestamp).Milliseconds(),
+	}
+	if sendErr != nil {
+		attempt.Error = sendErr.Error()
+	}
+	delivery.RecordAttempt(attempt)
+	if err := uc.outbox.SaveDelivery(ctx, delivery); err != nil {
+		return err
+	}
+
+	// Reload the subscription so concurrent updates through the API are kept
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	subscription, err = uc.webhookRepo.GetByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return nil
+	}
+	subscription.RecordResult(sendErr == nil, timestamp)
+	return uc.webhookRepo.Save(ctx, *subscription)
+}
+
+// newWebhookDelivery creates a pending delivery of the given data
+func newWebhookDelivery(subscriptionID, eventID, eventType string, data interface{}) (entities.WebhookDelivery, error) {
+	now := time.Now().UTC()
+	id := "whd_" + randomHex(12)
+
+	payload, err := json.Marshal(webhookPayload{
+		ID:        id,
+		Type:      eventType,
+		CreatedAt: now,
+		Data:      data,
+	})
+	if err != nil {
+		return entities.WebhookDelivery{}, err
+	}
+
+	return entities.WebhookDelivery{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         entities.WebhookDeliveryPending,
+		Attempts:       []entities.WebhookAttempt{},
+		CreatedAt:      now,
+	}, nil
+}
+
+// randomHex returns n random bytes encoded as hex
+func randomHex(n int) string {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf)
+}
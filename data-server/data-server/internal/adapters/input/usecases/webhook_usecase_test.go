@@ -0,0 +1,387 @@
+package usecases
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"data-server/internal/adapters/output/eventbus"
+	"data-server/internal/adapters/output/file"
+	"data-server/internal/adapters/output/memory"
+	"data-server/internal/adapters/output/webhook"
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/output"
+)
+
+const testWebhookSecret = "whsec_0123456789abcdef"
+
+// webhookReceiver is a subscriber endpoint answering with a configurable status and
+// recording the requests it receives
+type webhookReceiver struct {
+	mutex    sync.Mutex
+	status   int
+	requests []receivedWebhook
+}
+
+// receivedWebhook represents a request received by a webhookReceiver
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.requests = append(r.requests, receivedWebhook{header: req.Header.Clone(), body: body})
+	w.WriteHeader(r.status)
+}
+
+func (r *webhookReceiver) setStatus(status int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.status = status
+}
+
+func (r *webhookReceiver) received() []receivedWebhook {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]receivedWebhook(nil), r.requests...)
+}
+
+// newWebhookTest returns a webhook use case delivering to a receiver through a file
+// outbox, with an enabled subscription of the receiver
+func newWebhookTest(t *testing.T) (*WebhookUseCase, *file.WebhookRepository, *webhookReceiver, entities.WebhookSubscription) {
+	t.Helper()
+
+	receiver := &webhookReceiver{status: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	repo, err := file.NewWebhookRepository(filepath.Join(t.TempDir(), "webhooks.json"))
+	if err != nil {
+		t.Fatalf("NewWebhookRepository: %v", err)
+	}
+
+	subscription := entities.WebhookSubscription{
+		ID:        "wh_test",
+		URL:       server.URL + "/hook",
+		Secret:    testWebhookSecret,
+		Enabled:   true,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := repo.Save(context.Background(), subscription); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	uc := NewWebhookUseCase(repo, repo, webhook.NewHTTPSender(), eventbus.NewBus(), memory.NewEmptyValidatorRepository())
+	return uc, repo, receiver, subscription
+}
+
+// enqueueTestDelivery queues a delivery for the subscription and returns it
+func enqueueTestDelivery(t *testing.T, repo *file.WebhookRepository, subscriptionID string) entities.WebhookDelivery {
+	t.Helper()
+
+	delivery, err := newWebhookDelivery(subscriptionID, "114000-0", "staking.Slashed", map[string]int{"amount": 12})
+	if err != nil {
+		t.Fatalf("newWebhookDelivery: %v", err)
+	}
+	deliveries := []entities.WebhookDelivery{delivery}
+	if err := repo.Enqueue(context.Background(), deliveries...); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	return deliveries[0]
+}
+
+// storedDelivery returns the stored version of a delivery
+func storedDelivery(t *testing.T, repo *file.WebhookRepository, delivery entities.WebhookDelivery) entities.WebhookDelivery {
+	t.Helper()
+
+	deliveries, err := repo.GetBySubscription(context.Background(), delivery.SubscriptionID)
+	if err != nil {
+		t.Fatalf("GetBySubscription: %v", err)
+	}
+	for _, stored := range deliveries {
+		if stored.ID == delivery.ID {
+			return stored
+		}
+	}
+	t.Fatalf("delivery %s is not stored", delivery.ID)
+	return entities.WebhookDelivery{}
+}
+
+// storedSubscription returns the stored version of a subscription
+func storedSubscription(t *testing.T, repo *file.WebhookRepository, id string) entities.WebhookSubscription {
+	t.Helper()
+
+	subscription, err := repo.GetByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	return *subscription
+}
+
+func TestWebhookDeliverySignature(t *testing.T) {
+	uc, repo, receiver, subscription := newWebhookTest(t)
+	delivery := enqueueTestDelivery(t, repo, subscription.ID)
+
+	uc.dispatch(context.Background())
+
+	requests := receiver.received()
+	if len(requests) != 1 {
+		t.Fatalf("receiver got %d requests, want 1", len(requests))
+	}
+	request := requests[0]
+	if string(request.body) != string(delivery.Payload) {
+		t.Errorf("body = %s, want the payload %s", request.body, delivery.Payload)
+	}
+	if got := request.header.Get("X-Webhook-Id"); got != delivery.ID {
+		t.Errorf("X-Webhook-Id = %q, want %q", got, delivery.ID)
+	}
+	if got := request.header.Get("X-Webhook-Event"); got != "staking.Slashed" {
+		t.Errorf("X-Webhook-Event = %q, want staking.Slashed", got)
+	}
+
+	timestamp := request.header.Get("X-Webhook-Timestamp")
+	mac := hmac.New(sha256.New, []byte(testWebhookSecret))
+	mac.Write([]byte(timestamp + "." + string(request.body)))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if got := request.header.Get("X-Webhook-Signature"); !hmac.Equal([]byte(got), []byte(want)) {
+		t.Errorf("X-Webhook-Signature = %q, want the HMAC of timestamp.body %q", got, want)
+	}
+
+	stored := storedDelivery(t, repo, delivery)
+	if stored.Status != entities.WebhookDeliverySucceeded || len(stored.Attempts) != 1 {
+		t.Errorf("delivery status = %s with %d attempts, want succeeded with 1", stored.Status, len(stored.Attempts))
+	}
+}
+
+func TestWebhookDeliveryBackoffAfterServerError(t *testing.T) {
+	uc, repo, receiver, subscription := newWebhookTest(t)
+	receiver.setStatus(http.StatusInternalServerError)
+	delivery := enqueueTestDelivery(t, repo, subscription.ID)
+
+	uc.dispatch(context.Background())
+
+	stored := storedDelivery(t, repo, delivery)
+	if stored.Status != entities.WebhookDeliveryPending {
+		t.Fatalf("delivery status = %s, want pending", stored.Status)
+	}
+	if len(stored.Attempts) != 1 || stored.Attempts[0].StatusCode != http.StatusInternalServerError {
+		t.Fatalf("attempts = %+v, want one attempt answered with 500", stored.Attempts)
+	}
+	if stored.NextAttemptAt == nil {
+		t.Fatal("next attempt is not scheduled")
+	}
+	if delay := stored.NextAttemptAt.Sub(stored.Attempts[0].At); delay != entities.WebhookRetryBaseDelay {
+		t.Errorf("retry delay = %s, want %s", delay, entities.WebhookRetryBaseDelay)
+	}
+
+	// The retry is not due yet, so dispatching again does not send it
+	uc.dispatch(context.Background())
+	if got := len(receiver.received()); got != 1 {
+		t.Errorf("receiver got %d requests before the retry was due, want 1", got)
+	}
+}
+
+func TestWebhookDeliveryAbandonedAfterMaxAttempts(t *testing.T) {
+	uc, repo, receiver, subscription := newWebhookTest(t)
+	receiver.setStatus(http.StatusInternalServerError)
+	delivery := enqueueTestDelivery(t, repo, subscription.ID)
+
+	// Every attempt is made without waiting for its backoff
+	for i := 0; i < entities.WebhookMaxAttempts; i++ {
+		if err := uc.deliver(context.Background(), storedDelivery(t, repo, delivery)); err != nil {
+			t.Fatalf("deliver: %v", err)
+		}
+	}
+
+	stored := storedDelivery(t, repo, delivery)
+	if stored.Status != entities.WebhookDeliveryFailed {
+		t.Errorf("delivery status = %s, want failed", stored.Status)
+	}
+	if len(stored.Attempts) != entities.WebhookMaxAttempts {
+		t.Errorf("delivery has %d attempts, want %d", len(stored.Attempts), entities.WebhookMaxAttempts)
+	}
+	if stored.NextAttemptAt != nil || stored.CompletedAt == nil {
+		t.Errorf("abandoned delivery has next attempt %v and completion %v", stored.NextAttemptAt, stored.CompletedAt)
+	}
+
+	due, err := repo.GetDue(context.Background(), time.Now().Add(time.Hour), webhookDispatchBatch)
+	if err != nil {
+		t.Fatalf("GetDue: %v", err)
+	}
+	if len(due) != 0 {
+		t.Errorf("abandoned delivery is still due: %+v", due)
+	}
+}
+
+func TestWebhookSubscriptionDisabledAfterConsecutiveFailures(t *testing.T) {
+	uc, repo, receiver, subscription := newWebhookTest(t)
+	receiver.setStatus(http.StatusInternalServerError)
+
+	for i := 0; i < entities.WebhookDisableThreshold; i++ {
+		if got := storedSubscription(t, repo, subscription.ID); !got.Enabled {
+			t.Fatalf("subscription disabled after %d failures, want %d", i, entities.WebhookDisableThreshold)
+		}
+		delivery := enqueueTestDelivery(t, repo, subscription.ID)
+		if err := uc.deliver(context.Background(), delivery); err != nil {
+			t.Fatalf("deliver: %v", err)
+		}
+	}
+
+	disabled := storedSubscription(t, repo, subscription.ID)
+	if disabled.Enabled || disabled.DisabledReason == "" {
+		t.Fatalf("subscription enabled = %t with reason %q, want disabled", disabled.Enabled, disabled.DisabledReason)
+	}
+	if disabled.ConsecutiveFailures != entities.WebhookDisableThreshold {
+		t.Errorf("consecutive failures = %d, want %d", disabled.ConsecutiveFailures, entities.WebhookDisableThreshold)
+	}
+
+	// Deliveries of the disabled subscription are canceled without being sent
+	sent := len(receiver.received())
+	delivery := enqueueTestDelivery(t, repo, subscription.ID)
+	if err := uc.deliver(context.Background(), delivery); err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	if got := storedDelivery(t, repo, delivery).Status; got != entities.WebhookDeliveryCanceled {
+		t.Errorf("delivery status = %s, want canceled", got)
+	}
+	if got := len(receiver.received()); got != sent {
+		t.Errorf("receiver got %d requests after the subscription was disabled, want %d", got, sent)
+	}
+}
+
+// droppingBus is an event bus whose test can drop the current subscriber, as the bus
+// does with subscribers that fall behind
+type droppingBus struct {
+	*eventbus.Bus
+	mutex        sync.Mutex
+	subscription output.Subscription
+}
+
+func (b *droppingBus) Subscribe(buffer int) output.Subscription {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.subscription = b.Bus.Subscribe(buffer)
+	return b.subscription
+}
+
+func (b *droppingBus) drop() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.subscription.Cancel()
+}
+
+func (b *droppingBus) subscribed() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.subscription != nil
+}
+
+// queuedEvents returns the number of deliveries queued per event identifier
+func queuedEvents(t *testing.T, repo *file.WebhookRepository, subscriptionID string) map[string]int {
+	t.Helper()
+
+	deliveries, err := repo.GetBySubscription(context.Background(), subscriptionID)
+	if err != nil {
+		t.Fatalf("GetBySubscription: %v", err)
+	}
+	queued := map[string]int{}
+	for _, delivery := range deliveries {
+		queued[delivery.EventID]++
+	}
+	return queued
+}
+
+// waitFor fails the test if the condition does not hold within a few seconds
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWebhookRunReplaysEventsMissedOnTheBus(t *testing.T) {
+	_, repo, _, subscription := newWebhookTest(t)
+
+	ctx := context.Background()
+	validators := memory.NewEmptyValidatorRepository()
+	validator := entities.NewValidator("5F3sa2TJc...Good", entities.ValidatorTypeGood, "")
+	validator.AddEvent(*entities.NewEvent(100, "staking.Rewarded", map[string]interface{}{"amount": 1}))
+	if err := validators.Save(ctx, validator); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	bus := &droppingBus{Bus: eventbus.NewBus()}
+	publishing, err := eventbus.NewPublishingRepository(validators, bus)
+	if err != nil {
+		t.Fatalf("NewPublishingRepository: %v", err)
+	}
+	uc := NewWebhookUseCase(repo, repo, webhook.NewHTTPSender(), bus, validators)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		uc.Run(runCtx)
+	}()
+	defer func() {
+		cancel()
+		<-stopped
+	}()
+	waitFor(t, "the dispatcher to subscribe", bus.subscribed)
+
+	// A live event is queued from the bus
+	validator.AddEvent(*entities.NewEvent(200, "staking.Slashed", map[string]interface{}{"amount": 2}))
+	if err := publishing.Save(ctx, validator); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	waitFor(t, "the live event to be queued", func() bool {
+		return queuedEvents(t, repo, subscription.ID)["200-0"] == 1
+	})
+
+	// An event saved while the dispatcher is dropped by the bus is replayed
+	validator.AddEvent(*entities.NewEvent(300, "staking.Slashed", map[string]interface{}{"amount": 3}))
+	if err := validators.Save(ctx, validator); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	bus.drop()
+	waitFor(t, "the missed event to be replayed", func() bool {
+		return queuedEvents(t, repo, subscription.ID)["300-0"] == 1
+	})
+
+	// Publishing the replayed event again along with a new one only queues the new one
+	validator.AddEvent(*entities.NewEvent(400, "staking.Slashed", map[string]interface{}{"amount": 4}))
+	if err := publishing.Save(ctx, validator); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	waitFor(t, "the live event after the replay to be queued", func() bool {
+		return queuedEvents(t, repo, subscription.ID)["400-0"] == 1
+	})
+
+	want := map[string]int{"200-0": 1, "300-0": 1, "400-0": 1}
+	queued := queuedEvents(t, repo, subscription.ID)
+	if len(queued) != len(want) {
+		t.Fatalf("queued events = %v, want %v", queued, want)
+	}
+	for id, count := range want {
+		if queued[id] != count {
+			t.Errorf("event %s queued %d times, want %d", id, queued[id], count)
+		}
+	}
+}
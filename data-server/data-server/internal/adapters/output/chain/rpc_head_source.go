@@ -0,0 +1,93 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"data-server/internal/domain/entities"
+)
+
+// DefaultTimeout is the maximum duration of a chain head request
+const DefaultTimeout = 5 * time.Second
+
+// rpcRequest represents a JSON-RPC 2.0 request
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+// rpcHeaderResponse represents the JSON-RPC 2.0 response of chain_getHeader
+type rpcHeaderResponse struct {
+	Result *struct {
+		Number string `json:"number"`
+	} `json:"result"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// RPCHeadSource implements the chain head source interface with the chain_getHeader
+// JSON-RPC method of a Substrate node, over HTTP
+type RPCHeadSource struct {
+	url    string
+	client *http.Client
+}
+
+// NewRPCHeadSource creates a new chain head source for the node at the given HTTP URL
+func NewRPCHeadSource(url string) *RPCHeadSource {
+	return &RPCHeadSource{
+		url:    url,
+		client: &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// ChainHead returns the number of the latest block known to the node
+func (s *RPCHeadSource) ChainHead(ctx context.Context) (int, error) {
+	body, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: 1, Method: "chain_getHeader", Params: []interface{}{}})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", entities.ErrChainUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return 0, fmt.Errorf("%w: node responded with status %d", entities.ErrChainUnavailable, resp.StatusCode)
+	}
+
+	var header rpcHeaderResponse
+	if err := json.NewDecoder(resp.Body).Decode(&header); err != nil {
+		return 0, fmt.Errorf("%w: invalid response: %v", entities.ErrChainUnavailable, err)
+	}
+	if header.Error != nil {
+		return 0, fmt.Errorf("%w: %s (%d)", entities.ErrChainUnavailable, header.Error.Message, header.Error.Code)
+	}
+	if header.Result == nil {
+		return 0, fmt.Errorf("%w: response has no header", entities.ErrChainUnavailable)
+	}
+
+	// Block numbers are hex encoded, as in "0x1b4"
+	number, err := strconv.ParseInt(strings.TrimPrefix(header.Result.Number, "0x"), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid block number %q", entities.ErrChainUnavailable, header.Result.Number)
+	}
+	return int(number), nil
+}
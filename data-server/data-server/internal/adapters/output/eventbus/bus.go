@@ -0,0 +1,77 @@
+package eventbus
+
+import (
+	"sync"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/output"
+)
+
+// Bus implements the event bus interface with in-process fan-out to subscriber channels
+type Bus struct {
+	subscribers map[*subscription]struct{}
+	mutex       sync.Mutex
+}
+
+// NewBus creates a new in-memory event bus
+func NewBus() *Bus {
+	return &Bus{
+		subscribers: make(map[*subscription]struct{}),
+	}
+}
+
+// Publish delivers events to every subscriber. Publishing never blocks: a subscriber
+// whose buffer is full is dropped and its channel closed, so it can resume from the
+// last event it received
+func (b *Bus) Publish(events ...entities.ValidatorEvent) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for s := range b.subscribers {
+		for _, event := range events {
+			select {
+			case s.events <- event:
+				continue
+			default:
+			}
+			delete(b.subscribers, s)
+			close(s.events)
+			break
+		}
+	}
+}
+
+// Subscribe registers a subscriber that may have up to buffer events pending
+func (b *Bus) Subscribe(buffer int) output.Subscription {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	s := &subscription{
+		bus:    b,
+		events: make(chan entities.ValidatorEvent, buffer),
+	}
+	b.subscribers[s] = struct{}{}
+	return s
+}
+
+// subscription represents a subscriber of the in-memory event bus
+type subscription struct {
+	bus    *Bus
+	events chan entities.ValidatorEvent
+}
+
+// Events returns the channel events are delivered on
+func (s *subscription) Events() <-chan entities.ValidatorEvent {
+	return s.events
+}
+
+// Cancel unregisters the subscriber and closes its channel
+func (s *subscription) Cancel() {
+	s.bus.mutex.Lock()
+	defer s.bus.mutex.Unlock()
+
+	if _, exists := s.bus.subscribers[s]; exists {
+		delete(s.bus.subscribers, s)
+		close(s.events)
+	}
+}
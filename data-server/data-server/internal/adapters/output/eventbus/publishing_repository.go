@@ -0,0 +1,82 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/output"
+)
+
+// PublishingRepository decorates a validator repository, publishing the events added
+// to a validator on the event bus once the validator has been saved
+type PublishingRepository struct {
+	output.ValidatorRepository
+	bus       output.EventBus
+	published map[string]int
+	mutex     sync.Mutex
+}
+
+// NewPublishingRepository creates a new publishing repository. Events already stored
+// in the repository are considered published
+func NewPublishingRepository(repo output.ValidatorRepository, bus output.EventBus) (*PublishingRepository, error) {
+	validators, err := repo.GetAll(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	published := make(map[string]int, len(validators))
+	for _, validator := range validators {
+		published[validator.Stash] = len(validator.Events)
+	}
+
+	return &PublishingRepository{
+		ValidatorRepository: repo,
+		bus:                 bus,
+		published:           published,
+	}, nil
+}
+
+// Save saves a validator and publishes its new events
+func (r *PublishingRepository) Save(ctx context.Context, validator *entities.Validator) error {
+	if err := r.ValidatorRepository.Save(ctx, validator); err != nil {
+		return err
+	}
+	return r.publish(ctx, validator.Stash)
+}
+
+// Update updates a validator and publishes its new events
+func (r *PublishingRepository) Update(ctx context.Context, validator *entities.Validator) error {
+	if err := r.ValidatorRepository.Update(ctx, validator); err != nil {
+		return err
+	}
+	return r.publish(ctx, validator.Stash)
+}
+
+// publish publishes the events of the validator added since the last publication. The
+// validator is read back so events carry the index assigned by the repository
+func (r *PublishingRepository) publish(ctx context.Context, stash string) error {
+	validator, err := r.ValidatorRepository.GetByStash(ctx, stash)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	start := r.published[stash]
+	if start > len(validator.Events) {
+		start = len(validator.Events)
+	}
+
+	events := make([]entities.ValidatorEvent, 0, len(validator.Events)-start)
+	for _, event := range validator.Events[start:] {
+		events = append(events, entities.ValidatorEvent{Stash: stash, Event: event})
+	}
+	r.published[stash] = len(validator.Events)
+
+	if len(events) > 0 {
+		r.bus.Publish(events...)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"data-server/internal/domain/entities"
+)
+
+// maxResolvedAlerts is the number of resolved alerts kept in the alert history, older
+// ones are pruned
+const maxResolvedAlerts = 1000
+
+// alertState represents the content of the alert file
+type alertState struct {
+	Rules  []entities.AlertRule `json:"rules"`
+	Alerts []entities.Alert     `json:"alerts"`
+}
+
+// AlertRepository implements the alert and alert rule repository interfaces, persisting
+// the API managed rules and the alert history to a JSON file
+type AlertRepository struct {
+	path       string
+	state      alertState
+	persistErr error
+	mutex      sync.Mutex
+}
+
+// NewAlertRepository creates a new file backed alert repository, loading the existing
+// file if there is one
+func NewAlertRepository(path string) (*AlertRepository, error) {
+	repo := &AlertRepository{
+		path: path,
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(data, &repo.state); err != nil {
+			return nil, err
+		}
+	}
+
+	return repo, nil
+}
+
+// GetAllRules retrieves all alert rules
+func (r *AlertRepository) GetAllRules(ctx context.Context) ([]entities.AlertRule, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	rules := make([]entities.AlertRule, len(r.state.Rules))
+	copy(rules, r.state.Rules)
+	return rules, nil
+}
+
+// GetRuleByID retrieves an alert rule by its identifier
+func (r *AlertRepository) GetRuleByID(ctx context.Context, id string) (*entities.AlertRule, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, rule := range r.state.Rules {
+		if rule.ID == id {
+			return &rule, nil
+		}
+	}
+
+	return nil, entities.ErrAlertRuleNotFound
+}
+
+// SaveRule creates or replaces an alert rule
+func (r *AlertRepository) SaveRule(ctx context.Context, rule entities.AlertRule) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	replaced := false
+	for i := range r.state.Rules {
+		if r.state.Rules[i].ID == rule.ID {
+			r.state.Rules[i] = rule
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		r.state.Rules = append(r.state.Rules, rule)
+	}
+
+	return r.persist(ctx)
+}
+
+// DeleteRule deletes an alert rule
+func (r *AlertRepository) DeleteRule(ctx context.Context, id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	rules := r.state.Rules[:0]
+	found := false
+	for _, rule := range r.state.Rules {
+		if rule.ID == id {
+			found = true
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if !found {
+		return entities.ErrAlertRuleNotFound
+	}
+	r.state.Rules = rules
+
+	return r.persist(ctx)
+}
+
+// GetAll retrieves all firing and resolved alerts
+func (r *AlertRepository) GetAll(ctx context.Context) ([]entities.Alert, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	alerts := make([]entities.Alert, len(r.state.Alerts))
+	copy(alerts, r.state.Alerts)
+	return alerts, nil
+}
+
+// GetByID retrieves an alert by its identifier
+func (r *AlertRepository) GetByID(ctx context.Context, id string) (*entities.Alert, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, alert := range r.state.Alerts {
+		if alert.ID == id {
+			return &alert, nil
+		}
+	}
+
+	return nil, entities.ErrAlertNotFound
+}
+
+// Save creates or replaces alerts
+func (r *AlertRepository) Save(ctx context.Context, alerts ...entities.Alert) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	index := make(map[string]int, len(r.state.Alerts))
+	for i, alert := range r.state.Alerts {
+		index[alert.ID] = i
+	}
+	for _, alert := range alerts {
+		if i, ok := index[alert.ID]; ok {
+			r.state.Alerts[i] = alert
+			continue
+		}
+		index[alert.ID] = len(r.state.Alerts)
+		r.state.Alerts = append(r.state.Alerts, alert)
+	}
+	r.prune()
+
+	return r.persist(ctx)
+}
+
+// prune removes the oldest resolved alerts beyond the history size
+func (r *AlertRepository) prune() {
+	resolved := 0
+	for _, alert := range r.state.Alerts {
+		if alert.State == entities.AlertStateResolved {
+			resolved++
+		}
+	}
+	if resolved <= maxResolvedAlerts {
+		return
+	}
+
+	excess := resolved - maxResolvedAlerts
+	alerts := r.state.Alerts[:0]
+	for _, alert := range r.state.Alerts {
+		if excess > 0 && alert.State == entities.AlertStateResolved {
+			excess--
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+	r.state.Alerts = alerts
+}
+
+// CheckHealth returns the error of the last write of the alert file, the storage is
+// healthy until a write fails
+func (r *AlertRepository) CheckHealth(ctx context.Context) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.persistErr
+}
+
+// persist writes the state to the alert file, keeping the outcome for the health check
+func (r *AlertRepository) persist(ctx context.Context) error {
+	r.persistErr = r.write(ctx)
+	return r.persistErr
+}
+
+// write atomically writes the state to the alert file
+func (r *AlertRepository) write(ctx context.Context) error {
+	data, err := json.Marshal(r.state)
+	if err != nil {
+		return err
+	}
+
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("%w: %w", entities.ErrStorageUnavailable, err)
+	}
+	if err := os.Rename(tmp, r.path); err != nil {
+		return fmt.Errorf("%w: %w", entities.ErrStorageUnavailable, err)
+	}
+
+	slog.DebugContext(ctx, "Persisted alert state", "path", r.path, "bytes", len(data))
+	return nil
+}
@@ -0,0 +1,83 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"data-server/internal/domain/entities"
+
+	"gopkg.in/yaml.v3"
+)
+
+// alertRulesFile represents the content of the YAML alert rules file
+type alertRulesFile struct {
+	Rules []alertRuleConfig `yaml:"rules"`
+}
+
+// alertRuleConfig represents an alert rule in the YAML alert rules file
+type alertRuleConfig struct {
+	ID          string   `yaml:"id"`
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Kind        string   `yaml:"kind"`
+	Severity    string   `yaml:"severity"`
+	Threshold   float64  `yaml:"threshold"`
+	Window      int      `yaml:"window"`
+	Kinds       []string `yaml:"kinds"`
+	Stashes     []string `yaml:"stashes"`
+	Enabled     *bool    `yaml:"enabled"`
+}
+
+// LoadAlertRules reads the alert rules declared in a YAML file, dated by the file
+// modification time. A missing file declares no rules
+func LoadAlertRules(path string) ([]entities.AlertRule, error) {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	modified := info.ModTime().UTC()
+
+	var file alertRulesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	seen := map[string]bool{}
+	rules := make([]entities.AlertRule, 0, len(file.Rules))
+	for _, config := range file.Rules {
+		rule := entities.AlertRule{
+			ID:          config.ID,
+			Name:        config.Name,
+			Description: config.Description,
+			Kind:        entities.AlertRuleKind(config.Kind),
+			Severity:    entities.IncidentSeverity(config.Severity),
+			Threshold:   config.Threshold,
+			Window:      config.Window,
+			Kinds:       config.Kinds,
+			Stashes:     config.Stashes,
+			Enabled:     config.Enabled == nil || *config.Enabled,
+			Source:      entities.AlertRuleSourceFile,
+			CreatedAt:   modified,
+			UpdatedAt:   modified,
+		}
+		if err := rule.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: rule %q: %w", path, config.ID, err)
+		}
+		if seen[rule.ID] {
+			return nil, fmt.Errorf("%s: duplicate rule id %q", path, rule.ID)
+		}
+		seen[rule.ID] = true
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,166 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"data-server/internal/domain/entities"
+)
+
+// apiKeyState represents the content of the API key file
+type apiKeyState struct {
+	Keys []entities.APIKey `json:"keys"`
+}
+
+// APIKeyRepository implements the API key repository interface, persisting the keys to a
+// JSON file readable only by the server
+type APIKeyRepository struct {
+	path       string
+	state      apiKeyState
+	persistErr error
+	mutex      sync.Mutex
+}
+
+// NewAPIKeyRepository creates a new file backed API key repository, loading the existing
+// file if there is one
+func NewAPIKeyRepository(path string) (*APIKeyRepository, error) {
+	repo := &APIKeyRepository{
+		path: path,
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(data, &repo.state); err != nil {
+			return nil, err
+		}
+	}
+
+	return repo, nil
+}
+
+// GetAll retrieves all API keys
+func (r *APIKeyRepository) GetAll(ctx context.Context) ([]entities.APIKey, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	keys := make([]entities.APIKey, len(r.state.Keys))
+	copy(keys, r.state.Keys)
+	return keys, nil
+}
+
+// GetByID retrieves an API key by its identifier
+func (r *APIKeyRepository) GetByID(ctx context.Context, id string) (*entities.APIKey, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, key := range r.state.Keys {
+		if key.ID == id {
+			return &key, nil
+		}
+	}
+
+	return nil, entities.ErrAPIKeyNotFound
+}
+
+// GetByHash retrieves an API key by the hash of its secret
+func (r *APIKeyRepository) GetByHash(ctx context.Context, hash string) (*entities.APIKey, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, key := range r.state.Keys {
+		if key.Hash == hash {
+			return &key, nil
+		}
+	}
+
+	return nil, entities.ErrAPIKeyNotFound
+}
+
+// Save creates or replaces an API key
+func (r *APIKeyRepository) Save(ctx context.Context, key entities.APIKey) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	replaced := false
+	for i := range r.state.Keys {
+		if r.state.Keys[i].ID == key.ID {
+			r.state.Keys[i] = key
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		r.state.Keys = append(r.state.Keys, key)
+	}
+
+	return r.persist(ctx)
+}
+
+// Delete deletes an API key
+func (r *APIKeyRepository) Delete(ctx context.Context, id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	keys := r.state.Keys[:0]
+	found := false
+	for _, key := range r.state.Keys {
+		if key.ID == id {
+			found = true
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if !found {
+		return entities.ErrAPIKeyNotFound
+	}
+	r.state.Keys = keys
+
+	return r.persist(ctx)
+}
+
+// CheckHealth returns the error of the last write of the API key file, the storage is
+// healthy until a write fails
+func (r *APIKeyRepository) CheckHealth(ctx context.Context) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.persistErr
+}
+
+// persist writes the state to the API key file, keeping the outcome for the health check
+func (r *APIKeyRepository) persist(ctx context.Context) error {
+	r.persistErr = r.write(ctx)
+	return r.persistErr
+}
+
+// write atomically writes the state to the API key file
+func (r *APIKeyRepository) write(ctx context.Context) error {
+	data, err := json.Marshal(r.state)
+	if err != nil {
+		return err
+	}
+
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("%w: %w", entities.ErrStorageUnavailable, err)
+	}
+	if err := os.Rename(tmp, r.path); err != nil {
+		return fmt.Errorf("%w: %w", entities.ErrStorageUnavailable, err)
+	}
+
+	slog.DebugContext(ctx, "Persisted API key state", "path", r.path, "bytes", len(data))
+	return nil
+}
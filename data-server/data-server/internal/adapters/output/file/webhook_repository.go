@@ -0,0 +1,442 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"data-server/internal/domain/entities"
+)
+
+const (
+	// maxCompletedDeliveries is the number of finished deliveries kept per subscription in
+	// the delivery log, older ones are pruned
+	maxCompletedDeliveries = 500
+
+	// compactMinRecords is the number of records the delivery log holds before it is
+	// compacted, once it holds twice as many records as stored deliveries
+	compactMinRecords = 1000
+
+	// maxRecordSize is the largest record read from the delivery log, in bytes
+	maxRecordSize = 16 << 20
+)
+
+// webhookState represents the content of the webhook file
+type webhookState struct {
+	Sequence      int                            `json:"sequence"`
+	Subscriptions []entities.WebhookSubscription `json:"subscriptions"`
+}
+
+// deliveryRecord represents a line of the delivery log: a stored version of a delivery,
+// the deletion of the deliveries of a subscription, or the sequence reached when the log
+// was compacted. The last version of a delivery wins
+type deliveryRecord struct {
+	Delivery            *entities.WebhookDelivery `json:"delivery,omitempty"`
+	DeletedSubscription string                    `json:"deleted_subscription,omitempty"`
+	Sequence            int                       `json:"sequence,omitempty"`
+}
+
+// WebhookRepository implements the webhook repository and outbox interfaces so that
+// subscriptions and queued deliveries survive restarts. Subscriptions are persisted to a
+// JSON file, deliveries are appended to a log next to it, which is compacted once it
+// mostly holds outdated versions of the deliveries
+type WebhookRepository struct {
+	path       string
+	logPath    string
+	state      webhookState
+	deliveries []entities.WebhookDelivery
+	records    int
+	persistErr error
+	mutex      sync.Mutex
+}
+
+// NewWebhookRepository creates a new file backed webhook repository, loading the
+// existing file and delivery log if there are some. The delivery log of webhooks.json
+// is webhooks.deliveries.jsonl
+func NewWebhookRepository(path string) (*WebhookRepository, error) {
+	repo := &WebhookRepository{
+		path:    path,
+		logPath: strings.TrimSuffix(path, filepath.Ext(path)) + ".deliveries.jsonl",
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(data, &repo.state); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := repo.load(); err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+// GetAll retrieves all webhook subscriptions
+func (r *WebhookRepository) GetAll(ctx context.Context) ([]entities.WebhookSubscription, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	subscriptions := make([]entities.WebhookSubscription, len(r.state.Subscriptions))
+	copy(subscriptions, r.state.Subscriptions)
+	return subscriptions, nil
+}
+
+// GetByID retrieves a webhook subscription by its identifier
+func (r *WebhookRepository) GetByID(ctx context.Context, id string) (*entities.WebhookSubscription, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, subscription := range r.state.Subscriptions {
+		if subscription.ID == id {
+			return &subscription, nil
+		}
+	}
+
+	return nil, entities.ErrWebhookNotFound
+}
+
+// Save creates or replaces a webhook subscription
+func (r *WebhookRepository) Save(ctx context.Context, subscription entities.WebhookSubscription) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	replaced := false
+	for i := range r.state.Subscriptions {
+		if r.state.Subscriptions[i].ID == subscription.ID {
+			r.state.Subscriptions[i] = subscription
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		r.state.Subscriptions = append(r.state.Subscriptions, subscription)
+	}
+
+	return r.persist(r.write(ctx))
+}
+
+// Delete deletes a webhook subscription and its deliveries
+func (r *WebhookRepository) Delete(ctx context.Context, id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	subscriptions := r.state.Subscriptions[:0]
+	found := false
+	for _, subscription := range r.state.Subscriptions {
+		if subscription.ID == id {
+			found = true
+			continue
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	if !found {
+		return entities.ErrWebhookNotFound
+	}
+	r.state.Subscriptions = subscriptions
+	r.deleteDeliveries(id)
+
+	if err := r.persist(r.write(ctx)); err != nil {
+		return err
+	}
+	return r.persist(r.append(ctx, deliveryRecord{DeletedSubscription: id}))
+}
+
+// Enqueue stores new deliveries, assigning their sequence numbers in place
+func (r *WebhookRepository) Enqueue(ctx context.Context, deliveries ...entities.WebhookDelivery) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	records := make([]deliveryRecord, 0, len(deliveries))
+	for i := range deliveries {
+		r.state.Sequence++
+		deliveries[i].Sequence = r.state.Sequence
+		r.deliveries = append(r.deliveries, deliveries[i])
+		records = append(records, deliveryRecord{Delivery: &deliveries[i]})
+	}
+
+	return r.persist(r.append(ctx, records...))
+}
+
+// GetDue retrieves up to limit pending deliveries whose next attempt is due, oldest first
+func (r *WebhookRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]entities.WebhookDelivery, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var due []entities.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if len(due) >= limit {
+			break
+		}
+		if delivery.Status != entities.WebhookDeliveryPending {
+			continue
+		}
+		if delivery.NextAttemptAt != nil && delivery.NextAttemptAt.After(now) {
+			continue
+		}
+		due = append(due, delivery)
+	}
+
+	return due, nil
+}
+
+// GetBySubscription retrieves the deliveries of a webhook subscription
+func (r *WebhookRepository) GetBySubscription(ctx context.Context, subscriptionID string) ([]entities.WebhookDelivery, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var deliveries []entities.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.SubscriptionID == subscriptionID {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	return deliveries, nil
+}
+
+// SaveDelivery replaces a stored delivery, appending its new version to the delivery log
+func (r *WebhookRepository) SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.replaceDelivery(delivery) {
+		return errors.New("webhook delivery not found")
+	}
+	r.prune(delivery.SubscriptionID)
+
+	if err := r.append(ctx, deliveryRecord{Delivery: &delivery}); err != nil {
+		return r.persist(err)
+	}
+	if r.records >= compactMinRecords && r.records > 2*len(r.deliveries) {
+		return r.persist(r.compact(ctx))
+	}
+	return r.persist(nil)
+}
+
+// replaceDelivery replaces a stored delivery, returning false if it is not stored
+func (r *WebhookRepository) replaceDelivery(delivery entities.WebhookDelivery) bool {
+	for i := range r.deliveries {
+		if r.deliveries[i].ID == delivery.ID {
+			r.deliveries[i] = delivery
+			return true
+		}
+	}
+	return false
+}
+
+// deleteDeliveries removes the deliveries of a subscription
+func (r *WebhookRepository) deleteDeliveries(subscriptionID string) {
+	deliveries := r.deliveries[:0]
+	for _, delivery := range r.deliveries {
+		if delivery.SubscriptionID != subscriptionID {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	r.deliveries = deliveries
+}
+
+// prune removes the oldest finished deliveries of a subscription beyond the log size
+func (r *WebhookRepository) prune(subscriptionID string) {
+	completed := 0
+	for _, delivery := range r.deliveries {
+		if delivery.SubscriptionID == subscriptionID && delivery.Status != entities.WebhookDeliveryPending {
+			completed++
+		}
+	}
+	if completed <= maxCompletedDeliveries {
+		return
+	}
+
+	excess := completed - maxCompletedDeliveries
+	deliveries := r.deliveries[:0]
+	for _, delivery := range r.deliveries {
+		if excess > 0 && delivery.SubscriptionID == subscriptionID && delivery.Status != entities.WebhookDeliveryPending {
+			excess--
+			continue
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	r.deliveries = deliveries
+}
+
+// CheckHealth returns the error of the last write of the webhook file or the delivery
+// log, the storage is healthy until a write fails
+func (r *WebhookRepository) CheckHealth(ctx context.Context) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.persistErr
+}
+
+// persist keeps the outcome of a write for the health check
+func (r *WebhookRepository) persist(err error) error {
+	r.persistErr = err
+	return err
+}
+
+// write atomically writes the subscriptions and the sequence to the webhook file
+func (r *WebhookRepository) write(ctx context.Context) error {
+	data, err := json.Marshal(r.state)
+	if err != nil {
+		return err
+	}
+
+	if err := writeAtomically(r.path, data); err != nil {
+		return err
+	}
+
+	slog.DebugContext(ctx, "Persisted webhook subscriptions", "path", r.path, "bytes", len(data))
+	return nil
+}
+
+// load replays the delivery log, if there is one. A truncated last record, left by a
+// write interrupted by a crash, is skipped
+func (r *WebhookRepository) load() error {
+	file, err := os.Open(r.logPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxRecordSize)
+	var pending error
+	for scanner.Scan() {
+		if pending != nil {
+			return pending
+		}
+
+		var record deliveryRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			pending = fmt.Errorf("read webhook delivery log %s: record %d: %w", r.logPath, r.records+1, err)
+			continue
+		}
+		r.records++
+		r.replay(record)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read webhook delivery log %s: %w", r.logPath, err)
+	}
+	if pending != nil {
+		slog.Warn("Skipped the truncated last record of the webhook delivery log", "path", r.logPath, "error", pending)
+	}
+
+	return nil
+}
+
+// replay applies a record of the delivery log to the stored deliveries
+func (r *WebhookRepository) replay(record deliveryRecord) {
+	if record.Sequence > r.state.Sequence {
+		r.state.Sequence = record.Sequence
+	}
+	if record.DeletedSubscription != "" {
+		r.deleteDeliveries(record.DeletedSubscription)
+	}
+	if record.Delivery == nil {
+		return
+	}
+
+	delivery := *record.Delivery
+	if delivery.Sequence > r.state.Sequence {
+		r.state.Sequence = delivery.Sequence
+	}
+	if !r.replaceDelivery(delivery) {
+		r.deliveries = append(r.deliveries, delivery)
+	}
+	r.prune(delivery.SubscriptionID)
+}
+
+// append appends records to the delivery log
+func (r *WebhookRepository) append(ctx context.Context, records ...deliveryRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	data, err := encodeRecords(records)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(r.logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("%w: %w", entities.ErrStorageUnavailable, err)
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("%w: %w", entities.ErrStorageUnavailable, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("%w: %w", entities.ErrStorageUnavailable, err)
+	}
+
+	r.records += len(records)
+	slog.DebugContext(ctx, "Appended webhook deliveries", "path", r.logPath, "records", len(records), "bytes", len(data))
+	return nil
+}
+
+// compact atomically rewrites the delivery log with the sequence and the last version
+// of every stored delivery
+func (r *WebhookRepository) compact(ctx context.Context) error {
+	records := make([]deliveryRecord, 0, len(r.deliveries)+1)
+	records = append(records, deliveryRecord{Sequence: r.state.Sequence})
+	for i := range r.deliveries {
+		records = append(records, deliveryRecord{Delivery: &r.deliveries[i]})
+	}
+
+	data, err := encodeRecords(records)
+	if err != nil {
+		return err
+	}
+	if err := writeAtomically(r.logPath, data); err != nil {
+		return err
+	}
+
+	slog.DebugContext(ctx, "Compacted webhook delivery log", "path", r.logPath, "records", len(records), "previous_records", r.records)
+	r.records = len(records)
+	return nil
+}
+
+// encodeRecords encodes records as JSON lines
+func encodeRecords(records []deliveryRecord) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return nil, err
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
+// writeAtomically replaces a file with the given content through a temporary file
+func writeAtomically(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("%w: %w", entities.ErrStorageUnavailable, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("%w: %w", entities.ErrStorageUnavailable, err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package file
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"data-server/internal/domain/entities"
+)
+
+func TestWebhookRepositoryReplaysDeliveryLog(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "webhooks.json")
+	repo, err := NewWebhookRepository(path)
+	if err != nil {
+		t.Fatalf("NewWebhookRepository: %v", err)
+	}
+
+	for _, id := range []string{"wh_kept", "wh_deleted"} {
+		if err := repo.Save(ctx, entities.WebhookSubscription{ID: id, Enabled: true}); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	deliveries := []entities.WebhookDelivery{
+		{ID: "whd_1", SubscriptionID: "wh_kept", Status: entities.WebhookDeliveryPending},
+		{ID: "whd_2", SubscriptionID: "wh_kept", Status: entities.WebhookDeliveryPending},
+		{ID: "whd_3", SubscriptionID: "wh_deleted", Status: entities.WebhookDeliveryPending},
+	}
+	if err := repo.Enqueue(ctx, deliveries...); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+
+	// Enough versions of a delivery to compact the log
+	delivered := deliveries[0]
+	for i := 0; i < compactMinRecords; i++ {
+		delivered.RecordAttempt(entities.WebhookAttempt{At: time.Now().UTC(), Error: "timeout"})
+		delivered.Status = entities.WebhookDeliveryPending
+		if err := repo.SaveDelivery(ctx, delivered); err != nil {
+			t.Fatalf("SaveDelivery: %v", err)
+		}
+	}
+	delivered.RecordAttempt(entities.WebhookAttempt{At: time.Now().UTC()})
+	if err := repo.SaveDelivery(ctx, delivered); err != nil {
+		t.Fatalf("SaveDelivery: %v", err)
+	}
+	if err := repo.Delete(ctx, "wh_deleted"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if records := countLines(t, repo.logPath); records > compactMinRecords {
+		t.Errorf("delivery log holds %d records, want it compacted below %d", records, compactMinRecords)
+	}
+
+	reopened, err := NewWebhookRepository(path)
+	if err != nil {
+		t.Fatalf("NewWebhookRepository: %v", err)
+	}
+	kept, err := reopened.GetBySubscription(ctx, "wh_kept")
+	if err != nil {
+		t.Fatalf("GetBySubscription: %v", err)
+	}
+	if len(kept) != 2 {
+		t.Fatalf("reopened repository holds %d deliveries of wh_kept, want 2", len(kept))
+	}
+	if kept[0].Status != entities.WebhookDeliverySucceeded || len(kept[0].Attempts) != compactMinRecords+1 {
+		t.Errorf("replayed delivery is %s with %d attempts, want succeeded with %d", kept[0].Status, len(kept[0].Attempts), compactMinRecords+1)
+	}
+	if deleted, _ := reopened.GetBySubscription(ctx, "wh_deleted"); len(deleted) != 0 {
+		t.Errorf("reopened repository holds %d deliveries of a deleted subscription", len(deleted))
+	}
+
+	// Sequences continue after the replayed ones
+	next := []entities.WebhookDelivery{{ID: "whd_4", SubscriptionID: "wh_kept", Status: entities.WebhookDeliveryPending}}
+	if err := reopened.Enqueue(ctx, next...); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	if next[0].Sequence != 4 {
+		t.Errorf("sequence after reopening = %d, want 4", next[0].Sequence)
+	}
+}
+
+func TestWebhookRepositorySkipsTruncatedRecord(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "webhooks.json")
+	repo, err := NewWebhookRepository(path)
+	if err != nil {
+		t.Fatalf("NewWebhookRepository: %v", err)
+	}
+	if err := repo.Enqueue(ctx, entities.WebhookDelivery{ID: "whd_1", SubscriptionID: "wh_1", Status: entities.WebhookDeliveryPending}); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+
+	log, err := os.OpenFile(repo.logPath, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatalf("open delivery log: %v", err)
+	}
+	log.WriteString(`{"delivery":{"id":"whd_2","subscr`)
+	log.Close()
+
+	reopened, err := NewWebhookRepository(path)
+	if err != nil {
+		t.Fatalf("NewWebhookRepository with a truncated last record: %v", err)
+	}
+	deliveries, _ := reopened.GetBySubscription(ctx, "wh_1")
+	if len(deliveries) != 1 || deliveries[0].ID != "whd_1" {
+		t.Errorf("deliveries = %+v, want whd_1 only", deliveries)
+	}
+}
+
+// countLines returns the number of lines of a file
+func countLines(t *testing.T, path string) int {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open %s: %v", path, err)
+	}
+	defer file.Close()
+
+	lines := 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxRecordSize)
+	for scanner.Scan() {
+		lines++
+	}
+	return lines
+}
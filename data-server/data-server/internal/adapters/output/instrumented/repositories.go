@@ -0,0 +1,319 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+	"data-server/internal/ports/output"
+)
+
+// ValidatorRepository decorates a validator repository, recording the duration,
+// outcome and span of every operation
+type ValidatorRepository struct {
+	next output.ValidatorRepository
+	operations
+}
+
+// NewValidatorRepository creates a new instrumented validator repository
+func NewValidatorRepository(next output.ValidatorRepository, metrics output.Metrics, tracer output.Tracer) *ValidatorRepository {
+	return &ValidatorRepository{next: next, operations: newOperations("validator", "ValidatorRepository", metrics, tracer)}
+}
+
+// GetAll retrieves all validators
+func (r *ValidatorRepository) GetAll(ctx context.Context) (_ []*entities.Validator, err error) {
+	ctx, end := r.start(ctx, "GetAll")
+	defer end(&err)
+	return r.next.GetAll(ctx)
+}
+
+// GetPage retrieves the requested page of validators
+func (r *ValidatorRepository) GetPage(ctx context.Context, page valueobjects.PageRequest) (_ []*entities.Validator, _ valueobjects.PageInfo, err error) {
+	ctx, end := r.start(ctx, "GetPage")
+	defer end(&err)
+	return r.next.GetPage(ctx, page)
+}
+
+// GetByType retrieves a validator by its type
+func (r *ValidatorRepository) GetByType(ctx context.Context, validatorType string) (_ *entities.Validator, err error) {
+	ctx, end := r.start(ctx, "GetByType")
+	defer end(&err)
+	return r.next.GetByType(ctx, validatorType)
+}
+
+// GetByStash retrieves a validator by its stash address
+func (r *ValidatorRepository) GetByStash(ctx context.Context, stash string) (_ *entities.Validator, err error) {
+	ctx, end := r.start(ctx, "GetByStash")
+	defer end(&err)
+	return r.next.GetByStash(ctx, stash)
+}
+
+// GetEvents retrieves a snapshot of the events of all validators
+func (r *ValidatorRepository) GetEvents(ctx context.Context) (_ []entities.ValidatorEvent, err error) {
+	ctx, end := r.start(ctx, "GetEvents")
+	defer end(&err)
+	return r.next.GetEvents(ctx)
+}
+
+// QueryEvents retrieves the requested page of the events matching the query
+func (r *ValidatorRepository) QueryEvents(ctx context.Context, query entities.EventQuery, page valueobjects.PageRequest) (_ []entities.Event, _ valueobjects.PageInfo, err error) {
+	ctx, end := r.start(ctx, "QueryEvents")
+	defer end(&err)
+	return r.next.QueryEvents(ctx, query, page)
+}
+
+// Save saves a validator
+func (r *ValidatorRepository) Save(ctx context.Context, validator *entities.Validator) (err error) {
+	ctx, end := r.start(ctx, "Save")
+	defer end(&err)
+	return r.next.Save(ctx, validator)
+}
+
+// Update updates a validator
+func (r *ValidatorRepository) Update(ctx context.Context, validator *entities.Validator) (err error) {
+	ctx, end := r.start(ctx, "Update")
+	defer end(&err)
+	return r.next.Update(ctx, validator)
+}
+
+// Version retrieves the version of the validator data
+func (r *ValidatorRepository) Version(ctx context.Context) (_ *entities.DataVersion, err error) {
+	ctx, end := r.start(ctx, "Version")
+	defer end(&err)
+	return r.next.Version(ctx)
+}
+
+// WebhookRepository decorates a webhook subscription repository, recording the duration,
+// outcome and span of every operation
+type WebhookRepository struct {
+	next output.WebhookRepository
+	operations
+}
+
+// NewWebhookRepository creates a new instrumented webhook subscription repository
+func NewWebhookRepository(next output.WebhookRepository, metrics output.Metrics, tracer output.Tracer) *WebhookRepository {
+	return &WebhookRepository{next: next, operations: newOperations("webhook", "WebhookRepository", metrics, tracer)}
+}
+
+// GetAll retrieves all webhook subscriptions
+func (r *WebhookRepository) GetAll(ctx context.Context) (_ []entities.WebhookSubscription, err error) {
+	ctx, end := r.start(ctx, "GetAll")
+	defer end(&err)
+	return r.next.GetAll(ctx)
+}
+
+// GetByID retrieves a webhook subscription by its identifier
+func (r *WebhookRepository) GetByID(ctx context.Context, id string) (_ *entities.WebhookSubscription, err error) {
+	ctx, end := r.start(ctx, "GetByID")
+	defer end(&err)
+	return r.next.GetByID(ctx, id)
+}
+
+// Save creates or replaces a webhook subscription
+func (r *WebhookRepository) Save(ctx context.Context, subscription entities.WebhookSubscription) (err error) {
+	ctx, end := r.start(ctx, "Save")
+	defer end(&err)
+	return r.next.Save(ctx, subscription)
+}
+
+// Delete deletes a webhook subscription and its deliveries
+func (r *WebhookRepository) Delete(ctx context.Context, id string) (err error) {
+	ctx, end := r.start(ctx, "Delete")
+	defer end(&err)
+	return r.next.Delete(ctx, id)
+}
+
+// WebhookOutbox decorates a webhook outbox, recording the duration, outcome and span of
+// every operation
+type WebhookOutbox struct {
+	next output.WebhookOutbox
+	operations
+}
+
+// NewWebhookOutbox creates a new instrumented webhook outbox
+func NewWebhookOutbox(next output.WebhookOutbox, metrics output.Metrics, tracer output.Tracer) *WebhookOutbox {
+	return &WebhookOutbox{next: next, operations: newOperations("webhook_outbox", "WebhookOutbox", metrics, tracer)}
+}
+
+// Enqueue stores new deliveries
+func (o *WebhookOutbox) Enqueue(ctx context.Context, deliveries ...entities.WebhookDelivery) (err error) {
+	ctx, end := o.start(ctx, "Enqueue")
+	defer end(&err)
+	return o.next.Enqueue(ctx, deliveries...)
+}
+
+// GetDue retrieves up to limit pending deliveries whose next attempt is due
+func (o *WebhookOutbox) GetDue(ctx context.Context, now time.Time, limit int) (_ []entities.WebhookDelivery, err error) {
+	ctx, end := o.start(ctx, "GetDue")
+	defer end(&err)
+	return o.next.GetDue(ctx, now, limit)
+}
+
+// GetBySubscription retrieves the deliveries of a webhook subscription
+func (o *WebhookOutbox) GetBySubscription(ctx context.Context, subscriptionID string) (_ []entities.WebhookDelivery, err error) {
+	ctx, end := o.start(ctx, "GetBySubscription")
+	defer end(&err)
+	return o.next.GetBySubscription(ctx, subscriptionID)
+}
+
+// SaveDelivery replaces a stored delivery
+func (o *WebhookOutbox) SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) (err error) {
+	ctx, end := o.start(ctx, "SaveDelivery")
+	defer end(&err)
+	return o.next.SaveDelivery(ctx, delivery)
+}
+
+// AlertRuleRepository decorates an alert rule repository, recording the duration,
+// outcome and span of every operation
+type AlertRuleRepository struct {
+	next output.AlertRuleRepository
+	operations
+}
+
+// NewAlertRuleRepository creates a new instrumented alert rule repository
+func NewAlertRuleRepository(next output.AlertRuleRepository, metrics output.Metrics, tracer output.Tracer) *AlertRuleRepository {
+	return &AlertRuleRepository{next: next, operations: newOperations("alert_rule", "AlertRuleRepository", metrics, tracer)}
+}
+
+// GetAllRules retrieves all alert rules
+func (r *AlertRuleRepository) GetAllRules(ctx context.Context) (_ []entities.AlertRule, err error) {
+	ctx, end := r.start(ctx, "GetAllRules")
+	defer end(&err)
+	return r.next.GetAllRules(ctx)
+}
+
+// GetRuleByID retrieves an alert rule by its identifier
+func (r *AlertRuleRepository) GetRuleByID(ctx context.Context, id string) (_ *entities.AlertRule, err error) {
+	ctx, end := r.start(ctx, "GetRuleByID")
+	defer end(&err)
+	return r.next.GetRuleByID(ctx, id)
+}
+
+// SaveRule creates or replaces an alert rule
+func (r *AlertRuleRepository) SaveRule(ctx context.Context, rule entities.AlertRule) (err error) {
+	ctx, end := r.start(ctx, "SaveRule")
+	defer end(&err)
+	return r.next.SaveRule(ctx, rule)
+}
+
+// DeleteRule deletes an alert rule
+func (r *AlertRuleRepository) DeleteRule(ctx context.Context, id string) (err error) {
+	ctx, end := r.start(ctx, "DeleteRule")
+	defer end(&err)
+	return r.next.DeleteRule(ctx, id)
+}
+
+// AlertRepository decorates an alert repository, recording the duration, outcome and
+// span of every operation
+type AlertRepository struct {
+	next output.AlertRepository
+	operations
+}
+
+// NewAlertRepository creates a new instrumented alert repository
+func NewAlertRepository(next output.AlertRepository, metrics output.Metrics, tracer output.Tracer) *AlertRepository {
+	return &AlertRepository{next: next, operations: newOperations("alert", "AlertRepository", metrics, tracer)}
+}
+
+// GetAll retrieves all firing and resolved alerts
+func (r *AlertRepository) GetAll(ctx context.Context) (_ []entities.Alert, err error) {
+	ctx, end := r.start(ctx, "GetAll")
+	defer end(&err)
+	return r.next.GetAll(ctx)
+}
+
+// GetByID retrieves an alert by its identifier
+func (r *AlertRepository) GetByID(ctx context.Context, id string) (_ *entities.Alert, err error) {
+	ctx, end := r.start(ctx, "GetByID")
+	defer end(&err)
+	return r.next.GetByID(ctx, id)
+}
+
+// Save creates or replaces alerts
+func (r *AlertRepository) Save(ctx context.Context, alerts ...entities.Alert) (err error) {
+	ctx, end := r.start(ctx, "Save")
+	defer end(&err)
+	return r.next.Save(ctx, alerts...)
+}
+
+// APIKeyRepository decorates an API key repository, recording the duration, outcome and
+// span of every operation
+type APIKeyRepository struct {
+	next output.APIKeyRepository
+	operations
+}
+
+// NewAPIKeyRepository creates a new instrumented API key repository
+func NewAPIKeyRepository(next output.APIKeyRepository, metrics output.Metrics, tracer output.Tracer) *APIKeyRepository {
+	return &APIKeyRepository{next: next, operations: newOperations("api_key", "APIKeyRepository", metrics, tracer)}
+}
+
+// GetAll retrieves all API keys
+func (r *APIKeyRepository) GetAll(ctx context.Context) (_ []entities.APIKey, err error) {
+	ctx, end := r.start(ctx, "GetAll")
+	defer end(&err)
+	return r.next.GetAll(ctx)
+}
+
+// GetByID retrieves an API key by its identifier
+func (r *APIKeyRepository) GetByID(ctx context.Context, id string) (_ *entities.APIKey, err error) {
+	ctx, end := r.start(ctx, "GetByID")
+	defer end(&err)
+	return r.next.GetByID(ctx, id)
+}
+
+// GetByHash retrieves an API key by the hash of its secret
+func (r *APIKeyRepository) GetByHash(ctx context.Context, hash string) (_ *entities.APIKey, err error) {
+	ctx, end := r.start(ctx, "GetByHash")
+	defer end(&err)
+	return r.next.GetByHash(ctx, hash)
+}
+
+// Save creates or replaces an API key
+func (r *APIKeyRepository) Save(ctx context.Context, key entities.APIKey) (err error) {
+	ctx, end := r.start(ctx, "Save")
+	defer end(&err)
+	return r.next.Save(ctx, key)
+}
+
+// Delete deletes an API key
+func (r *APIKeyRepository) Delete(ctx context.Context, id string) (err error) {
+	ctx, end := r.start(ctx, "Delete")
+	defer end(&err)
+	return r.next.Delete(ctx, id)
+}
+
+// operations records the duration and outcome of the operations of a repository, and
+// traces each of them in a span
+type operations struct {
+	repository string
+	spanPrefix string
+	metrics    output.Metrics
+	tracer     output.Tracer
+}
+
+// newOperations creates a new operation recorder of a repository
+func newOperations(repository, spanPrefix string, metrics output.Metrics, tracer output.Tracer) operations {
+	return operations{
+		repository: repository,
+		spanPrefix: spanPrefix,
+		metrics:    metrics,
+		tracer:     tracer,
+	}
+}
+
+// start starts an operation, returning a copy of the context carrying its span and the
+// function ending it with its error
+func (o operations) start(ctx context.Context, operation string) (context.Context, func(*error)) {
+	start := time.Now()
+	ctx, span := o.tracer.Start(ctx, o.spanPrefix+"."+operation, map[string]string{
+		"repository": o.repository,
+		"operation":  operation,
+	})
+
+	return ctx, func(err *error) {
+		o.metrics.ObserveRepositoryOperation(o.repository, operation, time.Since(start), *err)
+		span.End(*err)
+	}
+}
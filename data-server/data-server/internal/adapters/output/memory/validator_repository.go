@@ -2,23 +2,30 @@ package memory
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
 	"sync"
 
 	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
 )
 
-// ValidatorRepository implements the validator repository interface using in-memory storage
+// ValidatorRepository implements the validator repository interface using in-memory storage.
+// Validators are stored as copies and handed out as copies, and the events of all
+// validators are kept ordered by block and event index
 type ValidatorRepository struct {
 	validators map[string]*entities.Validator
+	order      []string
+	events     []entities.ValidatorEvent
+	nextIndex  map[int]int
+	revision   uint64
 	mutex      sync.RWMutex
 }
 
 // NewValidatorRepository creates a new in-memory validator repository
 func NewValidatorRepository() *ValidatorRepository {
-	repo := &ValidatorRepository{
-		validators: make(map[string]*entities.Validator),
-	}
+	repo := NewEmptyValidatorRepository()
 
 	// Initialize with sample data
 	repo.initializeSampleData()
@@ -26,19 +33,38 @@ func NewValidatorRepository() *ValidatorRepository {
 	return repo
 }
 
+// NewEmptyValidatorRepository creates a new in-memory validator repository holding no
+// validators
+func NewEmptyValidatorRepository() *ValidatorRepository {
+	return &ValidatorRepository{
+		validators: make(map[string]*entities.Validator),
+		nextIndex:  make(map[int]int),
+	}
+}
+
 // GetAll retrieves all validators
 func (r *ValidatorRepository) GetAll(ctx context.Context) ([]*entities.Validator, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	var validators []*entities.Validator
-	for _, validator := range r.validators {
-		validators = append(validators, validator)
+	for _, validatorType := range r.order {
+		validators = append(validators, view(r.validators[validatorType]))
 	}
 
 	return validators, nil
 }
 
+// GetPage retrieves the requested page of validators, ordered by type
+func (r *ValidatorRepository) GetPage(ctx context.Context, page valueobjects.PageRequest) ([]*entities.Validator, valueobjects.PageInfo, error) {
+	validators, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, valueobjects.PageInfo{}, err
+	}
+
+	return valueobjects.Paginate(validators, (*entities.Validator).Position, page)
+}
+
 // GetByType retrieves a validator by its type
 func (r *ValidatorRepository) GetByType(ctx context.Context, validatorType string) (*entities.Validator, error) {
 	r.mutex.RLock()
@@ -46,10 +72,10 @@ func (r *ValidatorRepository) GetByType(ctx context.Context, validatorType strin
 
 	validator, exists := r.validators[validatorType]
 	if !exists {
-		return nil, errors.New("validator not found")
+		return nil, entities.ErrValidatorNotFound
 	}
 
-	return validator, nil
+	return view(validator), nil
 }
 
 // GetByStash retrieves a validator by its stash address
@@ -57,13 +83,45 @@ func (r *ValidatorRepository) GetByStash(ctx context.Context, stash string) (*en
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	for _, validator := range r.validators {
-		if validator.Stash == stash {
-			return validator, nil
+	for _, validatorType := range r.order {
+		if validator := r.validators[validatorType]; validator.Stash == stash {
+			return view(validator), nil
+		}
+	}
+
+	return nil, entities.ErrValidatorNotFound
+}
+
+// GetEvents retrieves a snapshot of the events of all validators, ordered by block and
+// event index. Saves replace the ordered events rather than modifying them, so the
+// snapshot is shared without being copied
+func (r *ValidatorRepository) GetEvents(ctx context.Context) ([]entities.ValidatorEvent, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.events[:len(r.events):len(r.events)], nil
+}
+
+// QueryEvents retrieves the requested page of the events of all validators matching
+// every criterion of the query, ordered by block and event index. The filter expression
+// of the query is evaluated outside of the lock
+func (r *ValidatorRepository) QueryEvents(ctx context.Context, query entities.EventQuery, page valueobjects.PageRequest) ([]entities.Event, valueobjects.PageInfo, error) {
+	r.mutex.RLock()
+	events := r.events
+	r.mutex.RUnlock()
+
+	var matched []entities.Event
+	for _, event := range events {
+		ok, err := query.Matches(ctx, event.Stash, event.Event)
+		if err != nil {
+			return nil, valueobjects.PageInfo{}, err
+		}
+		if ok {
+			matched = append(matched, event.Event)
 		}
 	}
 
-	return nil, errors.New("validator not found")
+	return valueobjects.PaginateOrdered(matched, entities.Event.Position, page)
 }
 
 // Save saves a validator
@@ -71,7 +129,17 @@ func (r *ValidatorRepository) Save(ctx context.Context, validator *entities.Vali
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
-	r.validators[string(validator.Type)] = validator
+	stored, exists := r.validators[string(validator.Type)]
+	if err := appendsEvents(stored, validator); err != nil {
+		return err
+	}
+	if !exists {
+		r.order = append(r.order, string(validator.Type))
+	}
+	r.store(stored, validator)
+	r.revision++
+
+	slog.DebugContext(ctx, "Saved validator", "validator", string(validator.Type), "stash", validator.Stash, "events", len(validator.Events), "revision", r.revision)
 	return nil
 }
 
@@ -80,11 +148,110 @@ func (r *ValidatorRepository) Update(ctx context.Context, validator *entities.Va
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
-	if _, exists := r.validators[string(validator.Type)]; !exists {
-		return errors.New("validator not found")
+	stored, exists := r.validators[string(validator.Type)]
+	if !exists {
+		return entities.ErrValidatorNotFound
+	}
+	if err := appendsEvents(stored, validator); err != nil {
+		return err
+	}
+
+	r.store(stored, validator)
+	r.revision++
+
+	slog.DebugContext(ctx, "Updated validator", "validator", string(validator.Type), "stash", validator.Stash, "events", len(validator.Events), "revision", r.revision)
+	return nil
+}
+
+// Version retrieves the version of the validator data, made of the number of saves and
+// updates, the highest event block and the latest validator update
+func (r *ValidatorRepository) Version(ctx context.Context) (*entities.DataVersion, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	version := &entities.DataVersion{Revision: r.revision}
+	for _, validator := range r.validators {
+		if block := validator.LatestBlock(); block > version.Block {
+			version.Block = block
+		}
+		if validator.UpdatedAt.After(version.UpdatedAt) {
+			version.UpdatedAt = validator.UpdatedAt
+		}
+	}
+
+	return version, nil
+}
+
+// store stores a copy of a validator, replacing its stored version if any. Events are
+// only ever appended: the events beyond those already stored are numbered after the
+// events of their block and added to the event ordering, while the stored events keep
+// their index so that cursors and event identifiers handed out stay valid
+func (r *ValidatorRepository) store(stored, validator *entities.Validator) {
+	var known []entities.Event
+	if stored != nil {
+		known = stored.Events
+	}
+
+	events := make([]entities.Event, 0, max(len(known), len(validator.Events)))
+	events = append(events, known...)
+	var added []entities.ValidatorEvent
+	for _, event := range validator.Events[min(len(known), len(validator.Events)):] {
+		event.Index = r.nextIndex[event.Block]
+		r.nextIndex[event.Block]++
+		events = append(events, event)
+		added = append(added, entities.ValidatorEvent{Stash: validator.Stash, Event: event})
 	}
 
-	r.validators[string(validator.Type)] = validator
+	copied := *validator
+	copied.Events = events
+	r.validators[string(validator.Type)] = &copied
+	r.events = mergeEvents(r.events, added)
+}
+
+// appendsEvents returns ErrEventsRewritten unless the events of the validator start with
+// the stored events, compared by block, type and hash since the repository numbers them
+func appendsEvents(stored, validator *entities.Validator) error {
+	if stored == nil {
+		return nil
+	}
+	if len(validator.Events) < len(stored.Events) {
+		return fmt.Errorf("%w: validator %s has %d events, %d are stored", entities.ErrEventsRewritten, validator.Type, len(validator.Events), len(stored.Events))
+	}
+	for i, known := range stored.Events {
+		event := validator.Events[i]
+		if event.Block != known.Block || event.Event != known.Event || event.Hash != known.Hash {
+			return fmt.Errorf("%w: validator %s event %d is %s at block %d, %s at block %d is stored", entities.ErrEventsRewritten, validator.Type, i, event.Event, event.Block, known.Event, known.Block)
+		}
+	}
+	return nil
+}
+
+// mergeEvents returns a new ordering of the events with the added events. The ordering
+// is never modified in place, so readers can hold it without the lock
+func mergeEvents(events, added []entities.ValidatorEvent) []entities.ValidatorEvent {
+	if len(added) == 0 {
+		return events
+	}
+
+	merged := make([]entities.ValidatorEvent, 0, len(events)+len(added))
+	merged = append(merged, events...)
+	merged = append(merged, added...)
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Position().Compare(merged[j].Position()) < 0
+	})
+	return merged
+}
+
+// view returns a copy of a stored validator, whose events cannot be appended to the
+// stored ones
+func view(validator *entities.Validator) *entities.Validator {
+	copied := *validator
+	copied.Events = validator.Events[:len(validator.Events):len(validator.Events)]
+	return &copied
+}
+
+// CheckHealth returns nil, the in-memory storage is always available
+func (r *ValidatorRepository) CheckHealth(ctx context.Context) error {
 	return nil
 }
 
@@ -285,7 +452,8 @@ func (r *ValidatorRepository) initializeSampleData() {
 		badValidator.AddEvent(event)
 	}
 
-	r.validators["good"] = goodValidator
-	r.validators["neutral"] = neutralValidator
-	r.validators["bad"] = badValidator
+	for _, validator := range []*entities.Validator{goodValidator, neutralValidator, badValidator} {
+		r.store(nil, validator)
+		r.order = append(r.order, string(validator.Type))
+	}
 }
@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"data-server/internal/domain/entities"
+)
+
+func TestValidatorRepositoryOnlyAppendsEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		events  func(stored []entities.Event) []entities.Event
+		wantErr error
+		want    int
+	}{
+		{
+			name:   "the stored events are saved unchanged",
+			events: func(stored []entities.Event) []entities.Event { return stored },
+			want:   2,
+		},
+		{
+			name: "events are appended",
+			events: func(stored []entities.Event) []entities.Event {
+				return append(stored, *entities.NewEvent(300, "staking.Slashed", map[string]interface{}{"amount": 3}))
+			},
+			want: 3,
+		},
+		{
+			name: "a modified event is rejected",
+			events: func(stored []entities.Event) []entities.Event {
+				stored[1].Event = "staking.Slashed"
+				return stored
+			},
+			wantErr: entities.ErrEventsRewritten,
+			want:    2,
+		},
+		{
+			name: "a removed event is rejected",
+			events: func(stored []entities.Event) []entities.Event {
+				return stored[1:]
+			},
+			wantErr: entities.ErrEventsRewritten,
+			want:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, update := range []bool{false, true} {
+			name := tt.name + " on save"
+			if update {
+				name = tt.name + " on update"
+			}
+			t.Run(name, func(t *testing.T) {
+				ctx := context.Background()
+				repo := NewEmptyValidatorRepository()
+				validator := entities.NewValidator("5F3sa2TJc...Good", entities.ValidatorTypeGood, "")
+				validator.AddEvent(*entities.NewEvent(100, "staking.Rewarded", map[string]interface{}{"amount": 1}))
+				validator.AddEvent(*entities.NewEvent(200, "staking.Rewarded", map[string]interface{}{"amount": 2}))
+				if err := repo.Save(ctx, validator); err != nil {
+					t.Fatalf("Save: %v", err)
+				}
+
+				stored, err := repo.GetByType(ctx, string(entities.ValidatorTypeGood))
+				if err != nil {
+					t.Fatalf("GetByType: %v", err)
+				}
+				stored.Events = tt.events(append([]entities.Event(nil), stored.Events...))
+				if update {
+					err = repo.Update(ctx, stored)
+				} else {
+					err = repo.Save(ctx, stored)
+				}
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+
+				events, err := repo.GetEvents(ctx)
+				if err != nil {
+					t.Fatalf("GetEvents: %v", err)
+				}
+				if len(events) != tt.want {
+					t.Fatalf("got %d stored events, want %d", len(events), tt.want)
+				}
+				if events[1].Event.Event != "staking.Rewarded" {
+					t.Errorf("stored event 1 is %s, want staking.Rewarded", events[1].Event.Event)
+				}
+			})
+		}
+	}
+}
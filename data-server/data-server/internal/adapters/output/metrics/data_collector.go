@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/ports/input"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// collectTimeout is the maximum duration of the reads made by a scrape
+const collectTimeout = 5 * time.Second
+
+// DataCollector collects the metrics of the stored data at every scrape: the events by
+// category and how far the ingestion trails the chain
+type DataCollector struct {
+	eventService     input.EventService
+	ingestionService input.IngestionService
+
+	events          *prometheus.Desc
+	lastStoredBlock *prometheus.Desc
+	lastUpdate      *prometheus.Desc
+	chainHead       *prometheus.Desc
+	lag             *prometheus.Desc
+
+	// The event counts are cached until the stored data changes, as computing them
+	// scans every event
+	cachedAt  entities.IngestionStatus
+	cached    map[string]int
+	cacheLock sync.Mutex
+}
+
+// NewDataCollector creates a new collector of the stored data metrics
+func NewDataCollector(eventService input.EventService, ingestionService input.IngestionService) *DataCollector {
+	return &DataCollector{
+		eventService:     eventService,
+		ingestionService: ingestionService,
+		events: prometheus.NewDesc(prometheus.BuildFQName(Namespace, "", "events"),
+			"Number of stored events, by category.", []string{"category"}, nil),
+		lastStoredBlock: prometheus.NewDesc(prometheus.BuildFQName(Namespace, "ingestion", "last_stored_block"),
+			"Highest block of the stored events.", nil, nil),
+		lastUpdate: prometheus.NewDesc(prometheus.BuildFQName(Namespace, "ingestion", "last_update_timestamp_seconds"),
+			"Time of the latest update of the stored data, in seconds since the Unix epoch.", nil, nil),
+		chainHead: prometheus.NewDesc(prometheus.BuildFQName(Namespace, "ingestion", "chain_head_block"),
+			"Latest block of the chain, reported when the chain node is configured and reachable.", nil, nil),
+		lag: prometheus.NewDesc(prometheus.BuildFQName(Namespace, "ingestion", "lag_blocks"),
+			"Number of blocks of the chain not stored yet, reported when the chain head is known.", nil, nil),
+	}
+}
+
+// Describe sends the descriptors of the metrics of the collector
+func (c *DataCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.events
+	ch <- c.lastStoredBlock
+	ch <- c.lastUpdate
+	ch <- c.chainHead
+	ch <- c.lag
+}
+
+// Collect reads the stored data and sends its metrics. Metrics that cannot be read are
+// logged and left out of the scrape
+func (c *DataCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	status, err := c.ingestionService.GetIngestionStatus(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get ingestion status for metrics", "error", err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.lastStoredBlock, prometheus.GaugeValue, float64(status.LastStoredBlock))
+	if !status.LastUpdatedAt.IsZero() {
+		ch <- prometheus.MustNewConstMetric(c.lastUpdate, prometheus.GaugeValue, float64(status.LastUpdatedAt.UnixNano())/1e9)
+	}
+	if status.ChainHead != nil {
+		ch <- prometheus.MustNewConstMetric(c.chainHead, prometheus.GaugeValue, float64(*status.ChainHead))
+	}
+	if lag, ok := status.Lag(); ok {
+		ch <- prometheus.MustNewConstMetric(c.lag, prometheus.GaugeValue, float64(lag))
+	}
+
+	counts, err := c.eventCounts(ctx, status)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get event statistics for metrics", "error", err)
+		return
+	}
+	for category, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.events, prometheus.GaugeValue, float64(count), category)
+	}
+}
+
+// eventCounts returns the number of events by category, computed again only when the
+// latest stored block or update has changed since the last scrape
+func (c *DataCollector) eventCounts(ctx context.Context, status *entities.IngestionStatus) (map[string]int, error) {
+	c.cacheLock.Lock()
+	defer c.cacheLock.Unlock()
+
+	if c.cached != nil && c.cachedAt.LastStoredBlock == status.LastStoredBlock && c.cachedAt.LastUpdatedAt.Equal(status.LastUpdatedAt) {
+		return c.cached, nil
+	}
+
+	stats, err := c.eventService.GetEventStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cachedAt = entities.IngestionStatus{LastStoredBlock: status.LastStoredBlock, LastUpdatedAt: status.LastUpdatedAt}
+	c.cached = stats.EventsByCategory
+	return c.cached, nil
+}
@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"data-server/internal/domain/domainerr"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefixes the names of the metrics of the service
+const Namespace = "data_server"
+
+// Prometheus implements the metrics interface with Prometheus collectors. They are
+// registered with a registry of its own, along with the Go runtime and process collectors
+type Prometheus struct {
+	registry           *prometheus.Registry
+	httpRequests       *prometheus.CounterVec
+	httpDuration       *prometheus.HistogramVec
+	repositoryDuration *prometheus.HistogramVec
+	useCaseDuration    *prometheus.HistogramVec
+}
+
+// NewPrometheus creates a new Prometheus metrics recorder
+func NewPrometheus() *Prometheus {
+	p := &Prometheus{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests served, by method, route and status code.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Duration of the HTTP requests, by method and route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		repositoryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "repository_operation_duration_seconds",
+			Help:      "Duration of the repository operations, by repository, operation and outcome.",
+			Buckets:   []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+		}, []string{"repository", "operation", "outcome"}),
+		useCaseDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "use_case_operation_duration_seconds",
+			Help:      "Duration of the use case operations, by use case, operation and outcome.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"use_case", "operation", "outcome"}),
+	}
+
+	p.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		p.httpRequests,
+		p.httpDuration,
+		p.repositoryDuration,
+		p.useCaseDuration,
+	)
+	return p
+}
+
+// Register adds collectors to the registry of the recorder
+func (p *Prometheus) Register(collectors ...prometheus.Collector) error {
+	for _, collector := range collectors {
+		if err := p.registry.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Handler returns an HTTP handler serving the metrics in the Prometheus exposition format
+func (p *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveHTTPRequest records an HTTP request served by a route
+func (p *Prometheus) ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	p.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	p.httpDuration.WithLabelValues(method, route).Observe(duration.Seconds())
+}
+
+// ObserveRepositoryOperation records a call of a repository and its error, if any
+func (p *Prometheus) ObserveRepositoryOperation(repository, operation string, duration time.Duration, err error) {
+	p.repositoryDuration.WithLabelValues(repository, operation, outcome(err)).Observe(duration.Seconds())
+}
+
+// ObserveUseCaseOperation records a call of a use case and its error, if any
+func (p *Prometheus) ObserveUseCaseOperation(useCase, operation string, duration time.Duration, err error) {
+	p.useCaseDuration.WithLabelValues(useCase, operation, outcome(err)).Observe(duration.Seconds())
+}
+
+// outcome returns the label of the result of an operation: success, the kind of a
+// domain error, or error for any other error
+func outcome(err error) string {
+	if err == nil {
+		return "success"
+	}
+	if kind := domainerr.KindOf(err); kind != "" {
+		return string(kind)
+	}
+	return "error"
+}
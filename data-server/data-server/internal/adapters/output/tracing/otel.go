@@ -0,0 +1,144 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"data-server/internal/domain/domainerr"
+	"data-server/internal/ports/output"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName names the tracer of the service
+const InstrumentationName = "data-server"
+
+const (
+	// ExporterNone disables the export of spans, incoming trace contexts are still
+	// propagated
+	ExporterNone = "none"
+	// ExporterStdout writes spans as JSON to the given writer
+	ExporterStdout = "stdout"
+	// ExporterOTLP sends spans to an OpenTelemetry collector with OTLP over gRPC
+	ExporterOTLP = "otlp"
+	// ExporterOTLPHTTP sends spans to an OpenTelemetry collector with OTLP over HTTP
+	ExporterOTLPHTTP = "otlphttp"
+)
+
+// Config represents the settings of the tracer provider. The OTLP exporters read their
+// endpoint, headers and TLS settings from the standard OTEL_EXPORTER_OTLP_* variables
+type Config struct {
+	Exporter       string
+	ServiceName    string
+	ServiceVersion string
+	// SampleRatio is the fraction of the traces started by the service that are
+	// sampled, traces started by a caller follow the sampling decision of the caller
+	SampleRatio float64
+	// Writer receives the spans of the stdout exporter
+	Writer io.Writer
+}
+
+// NewProvider creates a tracer provider exporting spans with the configured exporter,
+// and installs it with the W3C trace context and baggage propagators as the global ones
+func NewProvider(ctx context.Context, config Config) (*sdktrace.TracerProvider, error) {
+	if config.SampleRatio < 0 || config.SampleRatio > 1 {
+		return nil, fmt.Errorf("sample ratio %v is not between 0 and 1", config.SampleRatio)
+	}
+
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch config.Exporter {
+	case ExporterNone, "":
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(config.Writer))
+	case ExporterOTLP:
+		exporter, err = otlptracegrpc.New(ctx)
+	case ExporterOTLPHTTP:
+		exporter, err = otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q, expected %s, %s, %s or %s", config.Exporter, ExporterNone, ExporterStdout, ExporterOTLP, ExporterOTLPHTTP)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", config.Exporter, err)
+	}
+	if exporter != nil {
+		options = append(options, sdktrace.WithBatcher(exporter))
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", config.ServiceName),
+		attribute.String("service.version", config.ServiceVersion),
+	))
+	if err != nil {
+		return nil, err
+	}
+	options = append(options, sdktrace.WithResource(res))
+
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider, nil
+}
+
+// Tracer implements the tracer interface with an OpenTelemetry tracer
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer creates a new tracer over the spans of the given provider
+func NewTracer(provider trace.TracerProvider) *Tracer {
+	return &Tracer{
+		tracer: provider.Tracer(InstrumentationName),
+	}
+}
+
+// Start starts a span of an operation, child of the span carried by the context. The
+// operations outside a trace, such as the polling of the background workers, are not
+// traced
+func (t *Tracer) Start(ctx context.Context, name string, attributes map[string]string) (context.Context, output.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, &Span{span: trace.SpanFromContext(ctx)}
+	}
+
+	attrs := make([]attribute.KeyValue, 0, len(attributes))
+	for key, value := range attributes {
+		attrs = append(attrs, attribute.String(key, value))
+	}
+
+	ctx, span := t.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+	return ctx, &Span{span: span}
+}
+
+// Span implements the span interface with an OpenTelemetry span
+type Span struct {
+	span trace.Span
+}
+
+// End ends the span. Errors are recorded on the span, and mark it as failed unless they
+// are domain errors caused by the request, such as missing data or bad input
+func (s *Span) End(err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		switch kind := domainerr.KindOf(err); kind {
+		case "", domainerr.Unavailable:
+			s.span.SetStatus(codes.Error, err.Error())
+		default:
+			s.span.SetAttributes(attribute.String("error.kind", string(kind)))
+		}
+	}
+	s.span.End()
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultTimeout is the maximum duration of a webhook request
+const DefaultTimeout = 10 * time.Second
+
+// HTTPSender implements the webhook sender interface with an HTTP client
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender creates a new HTTP webhook sender
+func NewHTTPSender() *HTTPSender {
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout: DefaultTimeout,
+			// Redirects are not followed, subscribers must register the final URL
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send posts the body with the given headers to the URL and returns the response status
+// code. Non 2xx responses are returned as errors
+func (s *HTTPSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "blockchain-data-api-webhooks/1.0")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("subscriber responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,402 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"data-server/internal/adapters/output/tracing"
+	"data-server/pkg/logger"
+	"data-server/pkg/ratelimit"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DataSourceSample seeds the in-memory validator repository with sample validators
+	DataSourceSample = "sample"
+	// DataSourceMemory starts the in-memory validator repository without validators
+	DataSourceMemory = "memory"
+
+	// AllOrigins allows cross-origin requests from every origin
+	AllOrigins = "*"
+
+	// redacted replaces the secrets of a redacted configuration
+	redacted = "[REDACTED]"
+)
+
+// Config represents the configuration of the server
+type Config struct {
+	File      string          `yaml:"-" json:"file,omitempty"`
+	Server    ServerConfig    `yaml:"server" json:"server"`
+	Docs      DocsConfig      `yaml:"docs" json:"docs"`
+	Data      DataConfig      `yaml:"data" json:"data"`
+	Chain     ChainConfig     `yaml:"chain" json:"chain"`
+	Auth      AuthConfig      `yaml:"auth" json:"auth"`
+	RateLimit RateLimitConfig `yaml:"rate_limit" json:"rate_limit"`
+	Health    HealthConfig    `yaml:"health" json:"health"`
+	Log       LogConfig       `yaml:"log" json:"log"`
+	Tracing   TracingConfig   `yaml:"tracing" json:"tracing"`
+}
+
+// ServerConfig represents the listeners of the server. On shutdown the server reports
+// itself unready for the drain delay while still serving, so load balancers stop sending
+// it requests, then gives the in-flight requests and the background workers the shutdown
+// timeout to finish. The client IP of a request is read from the trusted platform header,
+// such as Fly-Client-IP, or from X-Forwarded-For when the request comes from one of the
+// trusted proxies, and is the address of the connection otherwise
+type ServerConfig struct {
+	Port            int      `yaml:"port" json:"port"`
+	GRPCPort        int      `yaml:"grpc_port" json:"grpc_port"`
+	CORSOrigins     []string `yaml:"cors_origins" json:"cors_origins"`
+	TrustedPlatform string   `yaml:"trusted_platform" json:"trusted_platform"`
+	TrustedProxies  []string `yaml:"trusted_proxies" json:"trusted_proxies"`
+	DrainDelay      Duration `yaml:"drain_delay" json:"drain_delay"`
+	ShutdownTimeout Duration `yaml:"shutdown_timeout" json:"shutdown_timeout"`
+}
+
+// Duration represents a duration written like 30s or 1m30s in the configuration
+type Duration time.Duration
+
+// MarshalJSON writes the duration like 30s
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalYAML reads a duration written like 30s
+func (d *Duration) UnmarshalYAML(node *yaml.Node) error {
+	parsed, err := time.ParseDuration(node.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: %q is not a duration such as 30s", node.Line, node.Value)
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// DocsConfig represents the API documentation. An empty base URL is derived from the
+// host of every documentation request, and an empty spec path serves and enforces the
+// OpenAPI spec embedded in the server. Validating the responses against the spec is
+// meant for debugging
+type DocsConfig struct {
+	BaseURL           string `yaml:"base_url" json:"base_url"`
+	SpecPath          string `yaml:"spec_path" json:"spec_path"`
+	ValidateResponses bool   `yaml:"validate_responses" json:"validate_responses"`
+}
+
+// DataConfig represents the storage of the server
+type DataConfig struct {
+	Source         string `yaml:"source" json:"source"`
+	Dir            string `yaml:"dir" json:"dir"`
+	AlertRulesFile string `yaml:"alert_rules_file" json:"alert_rules_file"`
+}
+
+// ChainConfig represents the node the ingestion lag is measured against, none when the
+// RPC URL is empty
+type ChainConfig struct {
+	RPCURL string `yaml:"rpc_url" json:"rpc_url"`
+}
+
+// AuthConfig represents the API key authentication
+type AuthConfig struct {
+	KeysRequired bool   `yaml:"keys_required" json:"keys_required"`
+	AdminAPIKey  string `yaml:"admin_api_key" json:"admin_api_key"`
+}
+
+// RateConfig represents the refill rate and capacity of the token buckets of a kind of
+// client, a rate of zero per minute is unlimited
+type RateConfig struct {
+	PerMinute int `yaml:"per_minute" json:"per_minute"`
+	Burst     int `yaml:"burst" json:"burst"`
+}
+
+// Rate returns the rate limit of the configuration
+func (r RateConfig) Rate() ratelimit.Rate {
+	return ratelimit.Rate{PerMinute: r.PerMinute, Burst: r.Burst}
+}
+
+// RateLimitConfig represents the rate limits of the clients with and without API key
+type RateLimitConfig struct {
+	IP  RateConfig `yaml:"ip" json:"ip"`
+	Key RateConfig `yaml:"key" json:"key"`
+}
+
+// HealthConfig represents the health checks. The ingestion is unhealthy when it trails
+// the chain head by more than the maximum lag in blocks, zero disables the lag check
+type HealthConfig struct {
+	MaxIngestionLag int `yaml:"max_ingestion_lag" json:"max_ingestion_lag"`
+}
+
+// LogConfig represents the logging of the server
+type LogConfig struct {
+	Level  string `yaml:"level" json:"level"`
+	Format string `yaml:"format" json:"format"`
+}
+
+// TracingConfig represents the export of the traces
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter" json:"exporter"`
+	SampleRatio float64 `yaml:"sample_ratio" json:"sample_ratio"`
+}
+
+// Default returns the configuration of the server when nothing is configured
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Port:            8080,
+			GRPCPort:        9090,
+			CORSOrigins:     []string{AllOrigins},
+			ShutdownTimeout: Duration(25 * time.Second),
+		},
+		Data: DataConfig{
+			Source:         DataSourceSample,
+			Dir:            "data",
+			AlertRulesFile: "config/alert_rules.yaml",
+		},
+		RateLimit: RateLimitConfig{
+			IP:  RateConfig{PerMinute: 300, Burst: 60},
+			Key: RateConfig{PerMinute: 1200, Burst: 200},
+		},
+		Health: HealthConfig{
+			MaxIngestionLag: 100,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: logger.FormatJSON,
+		},
+		Tracing: TracingConfig{
+			Exporter:    tracing.ExporterNone,
+			SampleRatio: 1,
+		},
+	}
+}
+
+// Load returns the configuration of the server given by the defaults, overridden by the
+// YAML file named by the -config flag or the CONFIG_FILE environment variable, then by
+// the environment variables and last by the command line flags. The configuration is
+// validated, flag.ErrHelp is returned when the usage was requested
+func Load(args []string) (*Config, error) {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	file := fs.String("config", os.Getenv("CONFIG_FILE"), "YAML configuration `file` (env CONFIG_FILE)")
+
+	// Flags are checked while parsing and applied after the file and the environment
+	type assignment struct {
+		setting setting
+		value   string
+	}
+	var flagged []assignment
+	for _, s := range settings {
+		if s.flag == "" {
+			continue
+		}
+		s := s
+		parse := func(value string) error {
+			scratch := Default()
+			if err := s.set(&scratch, value); err != nil {
+				return err
+			}
+			flagged = append(flagged, assignment{setting: s, value: value})
+			return nil
+		}
+		if s.boolean {
+			fs.BoolFunc(s.flag, s.usage+" (env "+s.env+")", parse)
+		} else {
+			fs.Func(s.flag, s.usage+" (env "+s.env+")", parse)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	config := Default()
+	if *file != "" {
+		if err := config.readFile(*file); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range settings {
+		if value, ok := os.LookupEnv(s.env); ok && value != "" {
+			if err := s.set(&config, value); err != nil {
+				return nil, fmt.Errorf("%s: %w", s.env, err)
+			}
+		}
+	}
+	for _, a := range flagged {
+		_ = a.setting.set(&config, a.value)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// readFile overrides the configuration with the keys of a YAML file, unknown keys are
+// rejected so that misspelled settings are not ignored
+func (c *Config) readFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	c.File = path
+	return nil
+}
+
+// Validate returns an error listing every invalid setting of the configuration
+func (c *Config) Validate() error {
+	var problems []string
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	check(validPort(c.Server.Port), "server.port: %d is not a port between 1 and 65535", c.Server.Port)
+	check(validPort(c.Server.GRPCPort), "server.grpc_port: %d is not a port between 1 and 65535", c.Server.GRPCPort)
+	check(c.Server.Port != c.Server.GRPCPort, "server.grpc_port: %d is also the HTTP port", c.Server.GRPCPort)
+	check(len(c.Server.CORSOrigins) > 0, "server.cors_origins: at least one origin, or %q, is required", AllOrigins)
+	for _, origin := range c.Server.CORSOrigins {
+		if origin == AllOrigins {
+			check(len(c.Server.CORSOrigins) == 1, "server.cors_origins: %q allows every origin and cannot be listed with others", AllOrigins)
+			continue
+		}
+		check(validOrigin(origin), "server.cors_origins: %q is not an origin such as https://example.com", origin)
+	}
+	for _, proxy := range c.Server.TrustedProxies {
+		check(validProxy(proxy), "server.trusted_proxies: %q is not an IP address or CIDR range", proxy)
+	}
+	check(c.Server.DrainDelay >= 0, "server.drain_delay: must not be negative")
+	check(c.Server.ShutdownTimeout > 0, "server.shutdown_timeout: must be positive")
+
+	if c.Docs.BaseURL != "" {
+		check(validBaseURL(c.Docs.BaseURL), "docs.base_url: %q is not an absolute http or https URL", c.Docs.BaseURL)
+	}
+
+	check(c.Data.Source == DataSourceSample || c.Data.Source == DataSourceMemory,
+		"data.source: unknown source %q, expected %s or %s", c.Data.Source, DataSourceSample, DataSourceMemory)
+	check(c.Data.Dir != "", "data.dir: is required")
+
+	if c.Chain.RPCURL != "" {
+		check(validBaseURL(c.Chain.RPCURL), "chain.rpc_url: %q is not an absolute http or https URL", redactURL(c.Chain.RPCURL))
+	}
+
+	check(c.RateLimit.IP.PerMinute >= 0, "rate_limit.ip.per_minute: must not be negative")
+	check(c.RateLimit.IP.Burst >= 0, "rate_limit.ip.burst: must not be negative")
+	check(c.RateLimit.Key.PerMinute >= 0, "rate_limit.key.per_minute: must not be negative")
+	check(c.RateLimit.Key.Burst >= 0, "rate_limit.key.burst: must not be negative")
+
+	check(c.Health.MaxIngestionLag >= 0, "health.max_ingestion_lag: must not be negative")
+
+	if _, err := logger.ParseLevel(c.Log.Level); err != nil {
+		problems = append(problems, "log.level: "+err.Error())
+	}
+	check(c.Log.Format == logger.FormatJSON || c.Log.Format == logger.FormatText,
+		"log.format: unknown format %q, expected %s or %s", c.Log.Format, logger.FormatJSON, logger.FormatText)
+
+	switch c.Tracing.Exporter {
+	case tracing.ExporterNone, tracing.ExporterStdout, tracing.ExporterOTLP, tracing.ExporterOTLPHTTP:
+	default:
+		problems = append(problems, fmt.Sprintf("tracing.exporter: unknown exporter %q, expected %s, %s, %s or %s",
+			c.Tracing.Exporter, tracing.ExporterNone, tracing.ExporterStdout, tracing.ExporterOTLP, tracing.ExporterOTLPHTTP))
+	}
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio: %g is not between 0 and 1", c.Tracing.SampleRatio)
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid configuration: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// AllowAllOrigins returns true if cross-origin requests are allowed from every origin
+func (c *Config) AllowAllOrigins() bool {
+	return len(c.Server.CORSOrigins) == 1 && c.Server.CORSOrigins[0] == AllOrigins
+}
+
+// Redacted returns a copy of the configuration without its secrets, safe to show
+func (c *Config) Redacted() Config {
+	redactedConfig := *c
+	redactedConfig.Server.CORSOrigins = append([]string(nil), c.Server.CORSOrigins...)
+	redactedConfig.Server.TrustedProxies = append([]string(nil), c.Server.TrustedProxies...)
+	if redactedConfig.Auth.AdminAPIKey != "" {
+		redactedConfig.Auth.AdminAPIKey = redacted
+	}
+	redactedConfig.Chain.RPCURL = redactURL(c.Chain.RPCURL)
+	return redactedConfig
+}
+
+// validPort returns true if the number is a TCP port a server can listen on
+func validPort(port int) bool {
+	return port > 0 && port <= 65535
+}
+
+// validBaseURL returns true if the value is an absolute http or https URL
+func validBaseURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// validOrigin returns true if the value is the scheme and host of an http or https URL,
+// without path
+func validOrigin(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && validBaseURL(value) && u.User == nil && u.Path == "" && u.RawQuery == "" && u.Fragment == ""
+}
+
+// validProxy returns true if the value is an IP address or a CIDR range
+func validProxy(value string) bool {
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return true
+	}
+	return net.ParseIP(value) != nil
+}
+
+// redactURL masks the credentials of a URL, which providers of RPC nodes carry in the
+// user information, the path or the query: the user information and the path are
+// replaced and only the names of the query parameters are kept
+func redactURL(value string) string {
+	if value == "" {
+		return ""
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return redacted
+	}
+
+	var b strings.Builder
+	if u.Scheme != "" {
+		b.WriteString(u.Scheme + "://")
+	}
+	if u.User != nil {
+		b.WriteString(redacted + "@")
+	}
+	b.WriteString(u.Host)
+	if u.Path != "" && u.Path != "/" {
+		b.WriteString("/" + redacted)
+	}
+	if u.RawQuery != "" {
+		var names []string
+		for name := range u.Query() {
+			names = append(names, url.QueryEscape(name)+"="+redacted)
+		}
+		sort.Strings(names)
+		b.WriteString("?" + strings.Join(names, "&"))
+	}
+	return b.String()
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// setting represents a configuration value set by an environment variable and, unless
+// it is a secret, by a command line flag
+type setting struct {
+	env     string
+	flag    string
+	usage   string
+	boolean bool
+	set     func(c *Config, value string) error
+}
+
+// settings lists the configuration values of the environment and the command line.
+// Secrets have no flag, so that they do not show in the process list
+var settings = []setting{
+	intSetting("PORT", "port", "HTTP `port`", func(c *Config) *int { return &c.Server.Port }),
+	intSetting("GRPC_PORT", "grpc-port", "gRPC `port`", func(c *Config) *int { return &c.Server.GRPCPort }),
+	stringSetting("TRUSTED_PLATFORM", "trusted-platform", "`header` carrying the client IP set by the platform in front of the server, such as Fly-Client-IP", func(c *Config) *string { return &c.Server.TrustedPlatform }),
+	listSetting("TRUSTED_PROXIES", "trusted-proxies", "comma separated IP addresses or CIDR `ranges` of the proxies whose X-Forwarded-For is trusted", func(c *Config) *[]string { return &c.Server.TrustedProxies }),
+	durationSetting("DRAIN_DELAY", "drain-delay", "`duration` the server keeps serving while reporting itself unready on shutdown", func(c *Config) *Duration { return &c.Server.DrainDelay }),
+	durationSetting("SHUTDOWN_TIMEOUT", "shutdown-timeout", "`duration` given to the in-flight requests and the workers to finish on shutdown", func(c *Config) *Duration { return &c.Server.ShutdownTimeout }),
+	listSetting("CORS_ORIGINS", "cors-origins", "comma separated `origins` allowed to make cross-origin requests, * for all", func(c *Config) *[]string { return &c.Server.CORSOrigins }),
+	stringSetting("DOCS_BASE_URL", "docs-base-url", "base `URL` of the API shown by the documentation, derived from the request when empty", func(c *Config) *string { return &c.Docs.BaseURL }),
+	stringSetting("OPENAPI_SPEC_PATH", "spec-path", "`path` of an OpenAPI specification replacing the embedded one", func(c *Config) *string { return &c.Docs.SpecPath }),
+	boolSetting("OPENAPI_VALIDATE_RESPONSES", "validate-responses", "replace the JSON responses which do not match the OpenAPI specification with 500 problems naming the error", func(c *Config) *bool { return &c.Docs.ValidateResponses }),
+	stringSetting("DATA_SOURCE", "data-source", "validator data `source`, sample or memory", func(c *Config) *string { return &c.Data.Source }),
+	stringSetting("DATA_DIR", "data-dir", "`directory` of the webhook, alert and API key files", func(c *Config) *string { return &c.Data.Dir }),
+	stringSetting("ALERT_RULES_FILE", "alert-rules-file", "YAML alert rules `file`", func(c *Config) *string { return &c.Data.AlertRulesFile }),
+	stringSetting("CHAIN_RPC_URL", "chain-rpc-url", "JSON-RPC `URL` of the node the ingestion lag is measured against", func(c *Config) *string { return &c.Chain.RPCURL }),
+	boolSetting("API_KEYS_REQUIRED", "api-keys-required", "reject the requests without API key", func(c *Config) *bool { return &c.Auth.KeysRequired }),
+	stringSetting("ADMIN_API_KEY", "", "", func(c *Config) *string { return &c.Auth.AdminAPIKey }),
+	intSetting("RATE_LIMIT_IP_PER_MINUTE", "rate-limit-ip-per-minute", "`tokens` per minute of the clients without API key, 0 for unlimited", func(c *Config) *int { return &c.RateLimit.IP.PerMinute }),
+	intSetting("RATE_LIMIT_IP_BURST", "rate-limit-ip-burst", "bucket capacity in `tokens` of the clients without API key", func(c *Config) *int { return &c.RateLimit.IP.Burst }),
+	intSetting("RATE_LIMIT_KEY_PER_MINUTE", "rate-limit-key-per-minute", "`tokens` per minute of the API keys, 0 for unlimited", func(c *Config) *int { return &c.RateLimit.Key.PerMinute }),
+	intSetting("RATE_LIMIT_KEY_BURST", "rate-limit-key-burst", "bucket capacity in `tokens` of the API keys", func(c *Config) *int { return &c.RateLimit.Key.Burst }),
+	intSetting("HEALTH_MAX_INGESTION_LAG", "health-max-ingestion-lag", "`blocks` the ingestion may trail the chain head before it is unhealthy, 0 disables the check", func(c *Config) *int { return &c.Health.MaxIngestionLag }),
+	stringSetting("LOG_LEVEL", "log-level", "minimum `level` of the logged records, debug, info, warn or error", func(c *Config) *string { return &c.Log.Level }),
+	stringSetting("LOG_FORMAT", "log-format", "`format` of the logged records, json or text", func(c *Config) *string { return &c.Log.Format }),
+	stringSetting("TRACING_EXPORTER", "tracing-exporter", "trace `exporter`, none, stdout, otlp or otlphttp", func(c *Config) *string { return &c.Tracing.Exporter }),
+	floatSetting("TRACING_SAMPLE_RATIO", "tracing-sample-ratio", "`fraction` of the traces started by the server that are sampled", func(c *Config) *float64 { return &c.Tracing.SampleRatio }),
+}
+
+// stringSetting returns a setting of a string value
+func stringSetting(env, flag, usage string, field func(c *Config) *string) setting {
+	return setting{env: env, flag: flag, usage: usage, set: func(c *Config, value string) error {
+		*field(c) = value
+		return nil
+	}}
+}
+
+// intSetting returns a setting of an integer value
+func intSetting(env, flag, usage string, field func(c *Config) *int) setting {
+	return setting{env: env, flag: flag, usage: usage, set: func(c *Config, value string) error {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		*field(c) = n
+		return nil
+	}}
+}
+
+// floatSetting returns a setting of a floating point value
+func floatSetting(env, flag, usage string, field func(c *Config) *float64) setting {
+	return setting{env: env, flag: flag, usage: usage, set: func(c *Config, value string) error {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		*field(c) = f
+		return nil
+	}}
+}
+
+// durationSetting returns a setting of a duration value
+func durationSetting(env, flag, usage string, field func(c *Config) *Duration) setting {
+	return setting{env: env, flag: flag, usage: usage, set: func(c *Config, value string) error {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration such as 30s", value)
+		}
+		*field(c) = Duration(d)
+		return nil
+	}}
+}
+
+// boolSetting returns a setting of a boolean value
+func boolSetting(env, flag, usage string, field func(c *Config) *bool) setting {
+	return setting{env: env, flag: flag, usage: usage, boolean: true, set: func(c *Config, value string) error {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%q is not true or false", value)
+		}
+		*field(c) = b
+		return nil
+	}}
+}
+
+// listSetting returns a setting of a comma separated list of values
+func listSetting(env, flag, usage string, field func(c *Config) *[]string) setting {
+	return setting{env: env, flag: flag, usage: usage, set: func(c *Config, value string) error {
+		var values []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+		*field(c) = values
+		return nil
+	}}
+}
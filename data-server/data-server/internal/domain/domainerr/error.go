@@ -0,0 +1,73 @@
+package domainerr
+
+import "errors"
+
+// Kind classifies domain errors. Adapters map every kind to the status codes of their
+// protocol, so clients can tell missing data from bad input
+type Kind string
+
+const (
+	// NotFound is returned when a requested resource does not exist
+	NotFound Kind = "not_found"
+	// InvalidInput is returned when a request argument is malformed
+	InvalidInput Kind = "invalid_input"
+	// InvalidRange is returned when a block or time range is empty or inverted
+	InvalidRange Kind = "invalid_range"
+	// InvalidAddress is returned when an account address is malformed
+	InvalidAddress Kind = "invalid_address"
+	// Unauthenticated is returned when a request carries no valid credentials
+	Unauthenticated Kind = "unauthenticated"
+	// Forbidden is returned when the credentials of a request lack a required permission
+	Forbidden Kind = "forbidden"
+	// RateLimited is returned when a client exceeds its request quota
+	RateLimited Kind = "rate_limited"
+	// Conflict is returned when a request conflicts with the state of a resource
+	Conflict Kind = "conflict"
+	// Unavailable is returned when a dependency fails temporarily and the request can be retried
+	Unavailable Kind = "unavailable"
+)
+
+// Error represents a domain error with a stable machine readable code. Errors are
+// declared once as sentinels and wrapped with fmt.Errorf("%w: ...") to add details
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+}
+
+// New creates a new domain error
+func New(kind Kind, code, message string) *Error {
+	return &Error{
+		Kind:    kind,
+		Code:    code,
+		Message: message,
+	}
+}
+
+// Error returns the message of the error
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// ErrorCode returns the stable code of the error
+func (e *Error) ErrorCode() string {
+	return e.Code
+}
+
+// As returns the first domain error in the chain of err
+func As(err error) (*Error, bool) {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
+
+// KindOf returns the kind of the first domain error in the chain of err, or "" if err
+// is not a domain error
+func KindOf(err error) Kind {
+	if domainErr, ok := As(err); ok {
+		return domainErr.Kind
+	}
+	return ""
+}
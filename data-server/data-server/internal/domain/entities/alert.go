@@ -0,0 +1,405 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"data-server/internal/domain/domainerr"
+	"data-server/internal/domain/valueobjects"
+)
+
+const (
+	// DefaultMissedSessionsThreshold is the number of consecutive sessions a validator
+	// must be reported offline for a missed_sessions rule to fire
+	DefaultMissedSessionsThreshold = 2
+
+	// DefaultRewardDropWindow is the number of previous rewards averaged by reward_drop rules
+	DefaultRewardDropWindow = 3
+
+	// DefaultOffenceWindow is the number of most recent sessions offence rules look at
+	DefaultOffenceWindow = 1
+
+	// commissionPerbill is the commission value of 100%, commissions are set in perbill
+	commissionPerbill = 1_000_000_000
+)
+
+var (
+	// ErrAlertNotFound is returned when an alert does not exist
+	ErrAlertNotFound = domainerr.New(domainerr.NotFound, "alert_not_found", "alert not found")
+
+	// ErrAlertRuleNotFound is returned when an alert rule does not exist
+	ErrAlertRuleNotFound = domainerr.New(domainerr.NotFound, "alert_rule_not_found", "alert rule not found")
+
+	// ErrInvalidAlertRule is returned when an alert rule is invalid
+	ErrInvalidAlertRule = domainerr.New(domainerr.InvalidInput, "invalid_alert_rule", "invalid alert rule")
+
+	// ErrAlertRuleReadOnly is returned when modifying an alert rule loaded from the rules file
+	ErrAlertRuleReadOnly = domainerr.New(domainerr.Conflict, "alert_rule_read_only", "alert rule is defined in the rules file and cannot be modified")
+)
+
+// AlertRuleKind represents the condition evaluated by an alert rule
+type AlertRuleKind string
+
+const (
+	// AlertRuleMissedSessions fires when a validator was reported offline for at least
+	// threshold consecutive sessions
+	AlertRuleMissedSessions AlertRuleKind = "missed_sessions"
+
+	// AlertRuleCommissionAbove fires when the commission of a validator is above
+	// threshold percent
+	AlertRuleCommissionAbove AlertRuleKind = "commission_above"
+
+	// AlertRuleRewardDrop fires when the latest reward of a validator dropped by at
+	// least threshold percent against the average of the window previous rewards
+	AlertRuleRewardDrop AlertRuleKind = "reward_drop"
+
+	// AlertRuleOffence fires when a validator committed an offence of one of the given
+	// kinds, any kind if none is given, within the window most recent sessions
+	AlertRuleOffence AlertRuleKind = "offence"
+)
+
+// IsValidAlertRuleKind returns true if the given rule kind is known
+func IsValidAlertRuleKind(kind string) bool {
+	switch AlertRuleKind(kind) {
+	case AlertRuleMissedSessions, AlertRuleCommissionAbove, AlertRuleRewardDrop, AlertRuleOffence:
+		return true
+	}
+	return false
+}
+
+// AlertRuleSource represents where an alert rule is defined
+type AlertRuleSource string
+
+const (
+	AlertRuleSourceFile AlertRuleSource = "file"
+	AlertRuleSourceAPI  AlertRuleSource = "api"
+)
+
+// AlertRule represents a declarative condition over validator behaviour
+type AlertRule struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Kind        AlertRuleKind    `json:"kind"`
+	Severity    IncidentSeverity `json:"severity"`
+	Threshold   float64          `json:"threshold,omitempty"`
+	Window      int              `json:"window,omitempty"`
+	Kinds       []string         `json:"kinds,omitempty"`
+	Stashes     []string         `json:"stashes,omitempty"`
+	Enabled     bool             `json:"enabled"`
+	Source      AlertRuleSource  `json:"source"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// Validate returns an error if the rule cannot be evaluated, applying the defaults of
+// its kind to unset parameters
+func (r *AlertRule) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("%w: id is required", ErrInvalidAlertRule)
+	}
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidAlertRule)
+	}
+	if r.Severity == "" {
+		r.Severity = IncidentSeverityMedium
+	}
+	if !IsValidIncidentSeverity(string(r.Severity)) {
+		return fmt.Errorf("%w: severity must be one of low, medium, high, critical", ErrInvalidAlertRule)
+	}
+	if r.Window < 0 {
+		return fmt.Errorf("%w: window must not be negative", ErrInvalidAlertRule)
+	}
+
+	switch r.Kind {
+	case AlertRuleMissedSessions:
+		if r.Threshold == 0 {
+			r.Threshold = DefaultMissedSessionsThreshold
+		}
+		if r.Threshold < 1 {
+			return fmt.Errorf("%w: threshold must be at least one session", ErrInvalidAlertRule)
+		}
+	case AlertRuleCommissionAbove:
+		if r.Threshold < 0 || r.Threshold >= 100 {
+			return fmt.Errorf("%w: threshold must be a percentage below 100", ErrInvalidAlertRule)
+		}
+	case AlertRuleRewardDrop:
+		if r.Threshold <= 0 || r.Threshold > 100 {
+			return fmt.Errorf("%w: threshold must be a percentage between 0 and 100", ErrInvalidAlertRule)
+		}
+		if r.Window == 0 {
+			r.Window = DefaultRewardDropWindow
+		}
+	case AlertRuleOffence:
+		if r.Window == 0 {
+			r.Window = DefaultOffenceWindow
+		}
+	default:
+		return fmt.Errorf("%w: kind must be one of missed_sessions, commission_above, reward_drop, offence", ErrInvalidAlertRule)
+	}
+
+	return nil
+}
+
+// AppliesTo returns true if the rule is evaluated for the given stash
+func (r *AlertRule) AppliesTo(stash string) bool {
+	return len(r.Stashes) == 0 || containsString(r.Stashes, stash)
+}
+
+// Position returns the stable position of the rule, ordered by identifier
+func (r AlertRule) Position() valueobjects.CursorKey {
+	return valueobjects.CursorKey{ID: r.ID}
+}
+
+// AlertCondition represents the result of evaluating a rule for a validator
+type AlertCondition struct {
+	Active  bool
+	Value   float64
+	Message string
+}
+
+// Evaluate evaluates the rule against the events of the validator
+func (r *AlertRule) Evaluate(v *Validator) AlertCondition {
+	events := v.SortedEvents()
+
+	switch r.Kind {
+	case AlertRuleMissedSessions:
+		missed := v.consecutiveMissedSessions(events)
+		return AlertCondition{
+			Active:  float64(missed) >= r.Threshold,
+			Value:   float64(missed),
+			Message: fmt.Sprintf("reported offline for %d consecutive sessions", missed),
+		}
+	case AlertRuleCommissionAbove:
+		commission, ok := v.commission(events)
+		return AlertCondition{
+			Active:  ok && commission > r.Threshold,
+			Value:   commission,
+			Message: fmt.Sprintf("commission is %.2f%%, above %.2f%%", commission, r.Threshold),
+		}
+	case AlertRuleRewardDrop:
+		drop, ok := v.rewardDrop(events, r.Window)
+		return AlertCondition{
+			Active:  ok && drop >= r.Threshold,
+			Value:   drop,
+			Message: fmt.Sprintf("latest reward dropped %.1f%% against the average of the previous %d rewards", drop, r.Window),
+		}
+	case AlertRuleOffence:
+		count := v.recentOffences(events, r.Kinds, r.Window)
+		return AlertCondition{
+			Active:  count > 0,
+			Value:   float64(count),
+			Message: fmt.Sprintf("%d offences within the last %d sessions", count, r.Window),
+		}
+	}
+
+	return AlertCondition{}
+}
+
+// consecutiveMissedSessions returns the number of most recent sessions in a row in which
+// an imOnline report listed the validator as offline. Sessions are those of the session
+// timeline, a session counts once however many reports it has, and any session without
+// such a report ends the streak, except the current session whose report may be yet to
+// come
+func (v *Validator) consecutiveMissedSessions(events []Event) int {
+	sessions := NewSessionTimeline(events)
+	reported := map[int]bool{}
+	offline := map[int]bool{}
+	for _, event := range events {
+		if event.Event != "imOnline.SomeOffline" && event.Event != "imOnline.AllGood" {
+			continue
+		}
+		session, ok := sessions.SessionAt(event.Block)
+		if !ok {
+			continue
+		}
+		reported[session] = true
+		if event.Event == "imOnline.SomeOffline" && containsString(event.GetStringListField("authority_ids"), v.Stash) {
+			offline[session] = true
+		}
+	}
+
+	last := len(sessions) - 1
+	if last >= 0 && !reported[sessions[last].Index] {
+		last--
+	}
+
+	missed := 0
+	for i := last; i >= 0 && offline[sessions[i].Index]; i-- {
+		missed++
+	}
+	return missed
+}
+
+// commission returns the latest commission of the validator in percent
+func (v *Validator) commission(events []Event) (float64, bool) {
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if event.Event != "staking.ValidatorPrefsSet" || !v.isOwnStash(event, "stash") {
+			continue
+		}
+		data, _ := event.Data.(map[string]interface{})
+		prefs := Event{Data: data["prefs"]}
+		if perbill, ok := prefs.GetIntField("commission"); ok {
+			return float64(perbill) * 100 / commissionPerbill, true
+		}
+	}
+	return 0, false
+}
+
+// rewardDrop returns how much the latest reward of the validator dropped, in percent,
+// against the average of up to window previous rewards
+func (v *Validator) rewardDrop(events []Event, window int) (float64, bool) {
+	var rewards []int64
+	for _, event := range events {
+		if event.Event != "staking.Rewarded" || !v.isOwnStash(event, "stash") {
+			continue
+		}
+		if amount, ok := event.GetAmount(); ok {
+			rewards = append(rewards, amount)
+		}
+	}
+	if len(rewards) < 2 {
+		return 0, false
+	}
+
+	latest := rewards[len(rewards)-1]
+	previous := rewards[:len(rewards)-1]
+	if len(previous) > window {
+		previous = previous[len(previous)-window:]
+	}
+
+	var total int64
+	for _, reward := range previous {
+		total += reward
+	}
+	average := float64(total) / float64(len(previous))
+	if average <= 0 {
+		return 0, false
+	}
+
+	return (average - float64(latest)) * 100 / average, true
+}
+
+// recentOffences returns the number of offences of the given kinds, any kind if none is
+// given, committed by the validator within the window most recent sessions
+func (v *Validator) recentOffences(events []Event, kinds []string, window int) int {
+	sessions := NewSessionTimeline(events)
+	if len(sessions) == 0 {
+		return 0
+	}
+	first := sessions[len(sessions)-1].Index - window + 1
+
+	count := 0
+	for _, offence := range ExtractOffences(events, sessions) {
+		if offence.Offender != v.Stash || offence.Session == nil || *offence.Session < first {
+			continue
+		}
+		if len(kinds) == 0 || containsString(kinds, offence.Kind) {
+			count++
+		}
+	}
+	return count
+}
+
+// AlertState represents whether the condition of an alert still holds
+type AlertState string
+
+const (
+	AlertStateFiring   AlertState = "firing"
+	AlertStateResolved AlertState = "resolved"
+)
+
+// Alert represents a period during which a rule held for a validator. Evaluations
+// finding the condition still active are deduplicated into the firing alert
+type Alert struct {
+	ID              string           `json:"id"`
+	Fingerprint     string           `json:"fingerprint"`
+	RuleID          string           `json:"rule_id"`
+	RuleName        string           `json:"rule_name"`
+	Kind            AlertRuleKind    `json:"kind"`
+	Severity        IncidentSeverity `json:"severity"`
+	Stash           string           `json:"stash"`
+	State           AlertState       `json:"state"`
+	Message         string           `json:"message"`
+	Value           float64          `json:"value"`
+	StartBlock      int              `json:"start_block"`
+	EndBlock        *int             `json:"end_block,omitempty"`
+	Evaluations     int              `json:"evaluations"`
+	StartsAt        time.Time        `json:"starts_at"`
+	LastEvaluatedAt time.Time        `json:"last_evaluated_at"`
+	ResolvedAt      *time.Time       `json:"resolved_at,omitempty"`
+}
+
+// AlertFingerprint returns the key identifying the alerts of a rule for a validator
+func AlertFingerprint(ruleID, stash string) string {
+	return ruleID + "/" + stash
+}
+
+// NewAlert creates a firing alert for a condition of a rule found at the given block
+func NewAlert(id string, rule *AlertRule, stash string, condition AlertCondition, block int, at time.Time) *Alert {
+	return &Alert{
+		ID:              id,
+		Fingerprint:     AlertFingerprint(rule.ID, stash),
+		RuleID:          rule.ID,
+		RuleName:        rule.Name,
+		Kind:            rule.Kind,
+		Severity:        rule.Severity,
+		Stash:           stash,
+		State:           AlertStateFiring,
+		Message:         condition.Message,
+		Value:           condition.Value,
+		StartBlock:      block,
+		Evaluations:     1,
+		StartsAt:        at,
+		LastEvaluatedAt: at,
+	}
+}
+
+// Refresh records an evaluation finding the condition of a firing alert still active
+func (a *Alert) Refresh(condition AlertCondition, at time.Time) {
+	a.Message = condition.Message
+	a.Value = condition.Value
+	a.Evaluations++
+	a.LastEvaluatedAt = at
+}
+
+// Resolve marks the alert as resolved at the given block
+func (a *Alert) Resolve(block int, at time.Time) {
+	a.State = AlertStateResolved
+	a.EndBlock = &block
+	a.LastEvaluatedAt = at
+	a.ResolvedAt = &at
+}
+
+// Position returns the stable position of the alert, ordered by start block
+func (a Alert) Position() valueobjects.CursorKey {
+	return valueobjects.CursorKey{Block: a.StartBlock, ID: a.ID}
+}
+
+// AlertQuery represents the criteria alerts are filtered by. Empty criteria match every alert
+type AlertQuery struct {
+	State       AlertState
+	RuleID      string
+	Stash       string
+	MinSeverity IncidentSeverity
+}
+
+// Matches returns true if the alert matches every criterion of the query
+func (q AlertQuery) Matches(alert Alert) bool {
+	return (q.State == "" || alert.State == q.State) &&
+		(q.RuleID == "" || alert.RuleID == q.RuleID) &&
+		(q.Stash == "" || alert.Stash == q.Stash) &&
+		(q.MinSeverity == "" || severityRank[alert.Severity] >= severityRank[q.MinSeverity])
+}
+
+// LatestBlock returns the highest block of the validator's events
+func (v *Validator) LatestBlock() int {
+	latest := 0
+	for _, event := range v.Events {
+		if event.Block > latest {
+			latest = event.Block
+		}
+	}
+	return latest
+}
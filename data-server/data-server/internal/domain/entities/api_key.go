@@ -0,0 +1,143 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"data-server/internal/domain/domainerr"
+	"data-server/internal/domain/valueobjects"
+)
+
+const (
+	// APIKeyPrefix starts every API key secret, so leaked keys are easy to recognize
+	APIKeyPrefix = "dsk_"
+
+	// apiKeyDisplayLength is the number of characters of a secret kept to identify a key
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+
+	// maxAPIKeyNameLength is the maximum length of the name of an API key
+	maxAPIKeyNameLength = 100
+)
+
+// APIKeyScope represents a permission granted to an API key
+type APIKeyScope string
+
+const (
+	// ScopeRead grants access to the validator, event and analytics data
+	ScopeRead APIKeyScope = "read"
+	// ScopeAdmin grants every permission, including the management of API keys,
+	// webhooks and alert rules
+	ScopeAdmin APIKeyScope = "admin"
+)
+
+// APIKeyScopes lists the supported scopes
+var APIKeyScopes = []APIKeyScope{ScopeRead, ScopeAdmin}
+
+var (
+	// ErrAPIKeyNotFound is returned when an API key does not exist
+	ErrAPIKeyNotFound = domainerr.New(domainerr.NotFound, "api_key_not_found", "API key not found")
+
+	// ErrInvalidAPIKey is returned when an API key is invalid
+	ErrInvalidAPIKey = domainerr.New(domainerr.InvalidInput, "invalid_api_key", "invalid API key")
+
+	// ErrUnauthenticated is returned when a request carries no valid API key
+	ErrUnauthenticated = domainerr.New(domainerr.Unauthenticated, "unauthenticated", "authentication required")
+
+	// ErrForbidden is returned when an API key lacks the scope an operation requires
+	ErrForbidden = domainerr.New(domainerr.Forbidden, "insufficient_scope", "insufficient scope")
+
+	// ErrRateLimited is returned when a client exceeds its rate limit
+	ErrRateLimited = domainerr.New(domainerr.RateLimited, "too_many_requests", "rate limit exceeded")
+)
+
+// APIKeyUsage represents the usage counters of an API key
+type APIKeyUsage struct {
+	Requests   int64      `json:"requests"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// APIKey represents a credential granting scopes to its holder. Only the hash of the
+// secret is stored, the secret itself is returned once when the key is created
+type APIKey struct {
+	ID        string        `json:"id"`
+	Name      string        `json:"name"`
+	Prefix    string        `json:"prefix"`
+	Hash      string        `json:"hash,omitempty"`
+	Scopes    []APIKeyScope `json:"scopes"`
+	RateLimit int           `json:"rate_limit"`
+	Usage     APIKeyUsage   `json:"usage"`
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// Validate returns an error if the key has no name, an unknown scope or a negative
+// rate limit
+func (k *APIKey) Validate() error {
+	if strings.TrimSpace(k.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidAPIKey)
+	}
+	if len(k.Name) > maxAPIKeyNameLength {
+		return fmt.Errorf("%w: name is longer than %d characters", ErrInvalidAPIKey, maxAPIKeyNameLength)
+	}
+	if len(k.Scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidAPIKey)
+	}
+	for _, scope := range k.Scopes {
+		if !scope.Valid() {
+			return fmt.Errorf("%w: unknown scope %q", ErrInvalidAPIKey, scope)
+		}
+	}
+	if k.RateLimit < 0 {
+		return fmt.Errorf("%w: rate_limit must not be negative", ErrInvalidAPIKey)
+	}
+	return nil
+}
+
+// Expired returns true if the key has expired at the given time
+func (k *APIKey) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// Principal returns the principal authenticated by the key
+func (k *APIKey) Principal() *Principal {
+	return &Principal{
+		KeyID:     k.ID,
+		Name:      k.Name,
+		Scopes:    k.Scopes,
+		RateLimit: k.RateLimit,
+	}
+}
+
+// Position returns the stable position of the key, ordered by identifier
+func (k APIKey) Position() valueobjects.CursorKey {
+	return valueobjects.CursorKey{ID: k.ID}
+}
+
+// Valid returns true if the scope is supported
+func (s APIKeyScope) Valid() bool {
+	for _, scope := range APIKeyScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// HashAPIKey returns the hash under which the secret of an API key is stored. Secrets
+// are long random strings, so a plain SHA-256 cannot be reversed by brute force
+func HashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// APIKeyDisplayPrefix returns the start of a secret, shown to identify the key
+func APIKeyDisplayPrefix(secret string) string {
+	if len(secret) < apiKeyDisplayLength {
+		return secret
+	}
+	return secret[:apiKeyDisplayLength]
+}
@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// DataVersion identifies the state of the validator data. It changes whenever a
+// validator is saved or updated, so responses derived from the data can be cached
+// until the version moves on
+type DataVersion struct {
+	// Revision counts the changes made to the repository since it was created
+	Revision uint64 `json:"revision"`
+	// Block is the highest block of the ingested events
+	Block int `json:"block"`
+	// UpdatedAt is the latest update of a validator
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Tag returns an opaque identifier of the version, suitable for an entity tag
+func (v DataVersion) Tag() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d-%d-%d", v.Revision, v.Block, v.UpdatedAt.UnixNano())))
+	return hex.EncodeToString(sum[:8])
+}
+
+// LastModified returns the latest update of a validator at the second precision of
+// HTTP dates
+func (v DataVersion) LastModified() time.Time {
+	return v.UpdatedAt.UTC().Truncate(time.Second)
+}
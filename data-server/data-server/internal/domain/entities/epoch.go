@@ -0,0 +1,138 @@
+package entities
+
+import (
+	"sort"
+
+	"data-server/internal/domain/valueobjects"
+)
+
+// Epoch represents a BABE epoch and the validators observed in its authority set
+type Epoch struct {
+	Index            int      `json:"index"`
+	StartBlock       int      `json:"start_block,omitempty"`
+	FinalizedBlock   int      `json:"finalized_block,omitempty"`
+	Finalized        bool     `json:"finalized"`
+	AuthorityChanges int      `json:"authority_changes"`
+	Authorities      []string `json:"authorities"`
+}
+
+// ConsensusParticipation represents the BABE consensus participation of a validator
+type ConsensusParticipation struct {
+	EpochsInAuthoritySet int  `json:"epochs_in_authority_set"`
+	EpochsFinalized      int  `json:"epochs_finalized"`
+	FirstEpoch           *int `json:"first_epoch,omitempty"`
+	LastEpoch            *int `json:"last_epoch,omitempty"`
+	LastEpochBlock       int  `json:"last_epoch_block,omitempty"`
+	AuthoritySetChanges  int  `json:"authority_set_changes"`
+	BlocksAuthored       int  `json:"blocks_authored"`
+}
+
+// Position returns the stable position of the epoch, ordered by epoch index
+func (e Epoch) Position() valueobjects.CursorKey {
+	return valueobjects.CursorKey{Index: e.Index}
+}
+
+// IsConsensusEvent returns true if the event is a BABE consensus event
+func (e *Event) IsConsensusEvent() bool {
+	return e.Event == "babe.EpochStarted" ||
+		e.Event == "babe.EpochFinalized" ||
+		e.Event == "babe.AuthoritiesChanged"
+}
+
+// ConsensusParticipation derives the consensus participation of the validator from the
+// BABE epoch events in its history. A validator is counted in the authority set of every
+// epoch it observed starting. Blocks authored are counted from events carrying an author
+// field, which stays zero until block author data is ingested
+func (v *Validator) ConsensusParticipation() ConsensusParticipation {
+	participation := ConsensusParticipation{}
+	epochs := map[int]bool{}
+
+	for _, event := range v.SortedEvents() {
+		if author, ok := event.GetStringField("author"); ok && author == v.Stash {
+			participation.BlocksAuthored++
+		}
+
+		switch event.Event {
+		case "babe.EpochStarted":
+			index, ok := event.GetIntField("epoch_index")
+			if !ok || epochs[int(index)] {
+				continue
+			}
+			epoch := int(index)
+			epochs[epoch] = true
+			participation.EpochsInAuthoritySet++
+			if participation.FirstEpoch == nil || epoch < *participation.FirstEpoch {
+				participation.FirstEpoch = &epoch
+			}
+			if participation.LastEpoch == nil || epoch > *participation.LastEpoch {
+				participation.LastEpoch = &epoch
+				participation.LastEpochBlock = event.Block
+			}
+		case "babe.EpochFinalized":
+			participation.EpochsFinalized++
+		case "babe.AuthoritiesChanged":
+			participation.AuthoritySetChanges++
+		}
+	}
+
+	return participation
+}
+
+// BuildEpochs builds the epoch model from the BABE events of all validators. Authority
+// set changes are attributed to the latest epoch started before them
+func BuildEpochs(validators []*Validator) []Epoch {
+	epochs := map[int]*Epoch{}
+	epochFor := func(index int) *Epoch {
+		epoch, exists := epochs[index]
+		if !exists {
+			epoch = &Epoch{Index: index, Authorities: []string{}}
+			epochs[index] = epoch
+		}
+		return epoch
+	}
+
+	for _, validator := range validators {
+		var current *Epoch
+		for _, event := range validator.SortedEvents() {
+			switch event.Event {
+			case "babe.EpochStarted":
+				index, ok := event.GetIntField("epoch_index")
+				if !ok {
+					continue
+				}
+				current = epochFor(int(index))
+				if current.StartBlock == 0 || event.Block < current.StartBlock {
+					current.StartBlock = event.Block
+				}
+				if !containsString(current.Authorities, validator.Stash) {
+					current.Authorities = append(current.Authorities, validator.Stash)
+				}
+			case "babe.EpochFinalized":
+				index, ok := event.GetIntField("epoch_index")
+				if !ok {
+					continue
+				}
+				epoch := epochFor(int(index))
+				epoch.Finalized = true
+				if epoch.FinalizedBlock == 0 || event.Block < epoch.FinalizedBlock {
+					epoch.FinalizedBlock = event.Block
+				}
+			case "babe.AuthoritiesChanged":
+				if current != nil {
+					current.AuthorityChanges++
+				}
+			}
+		}
+	}
+
+	result := make([]Epoch, 0, len(epochs))
+	for _, epoch := range epochs {
+		sort.Strings(epoch.Authorities)
+		result = append(result, *epoch)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Index < result[j].Index
+	})
+
+	return result
+}
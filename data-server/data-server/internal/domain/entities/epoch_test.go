@@ -0,0 +1,98 @@
+package entities
+
+import "testing"
+
+func epochStarted(block, index int) Event {
+	return *NewEvent(block, "babe.EpochStarted", map[string]interface{}{"epoch_index": index})
+}
+
+func epochFinalized(block, index int) Event {
+	return *NewEvent(block, "babe.EpochFinalized", map[string]interface{}{"epoch_index": index})
+}
+
+func authoritiesChanged(block int) Event {
+	return *NewEvent(block, "babe.AuthoritiesChanged", map[string]interface{}{})
+}
+
+func TestBuildEpochsAttributesEventsToEpochBoundaries(t *testing.T) {
+	good := NewValidator("5F3sa2TJc...Good", ValidatorTypeGood, "")
+	for _, event := range []Event{
+		epochStarted(100, 1),
+		authoritiesChanged(150),
+		epochFinalized(190, 1),
+		// A change in the first block of epoch 2 belongs to epoch 2
+		epochStarted(200, 2),
+		authoritiesChanged(200),
+	} {
+		good.AddEvent(event)
+	}
+
+	// bad saw epoch 2 start later and never saw epoch 1 start
+	bad := NewValidator("5HGjWAeFD...Bad", ValidatorTypeBad, "")
+	for _, event := range []Event{
+		authoritiesChanged(90),
+		epochFinalized(195, 1),
+		epochStarted(205, 2),
+		epochStarted(300, 3),
+	} {
+		bad.AddEvent(event)
+	}
+
+	epochs := BuildEpochs([]*Validator{bad, good})
+
+	if len(epochs) != 3 {
+		t.Fatalf("got %d epochs, want 3: %+v", len(epochs), epochs)
+	}
+
+	one, two, three := epochs[0], epochs[1], epochs[2]
+	if one.Index != 1 || one.StartBlock != 100 || !one.Finalized || one.FinalizedBlock != 190 || one.AuthorityChanges != 1 {
+		t.Errorf("epoch 1 = %+v", one)
+	}
+	if len(one.Authorities) != 1 || one.Authorities[0] != good.Stash {
+		t.Errorf("epoch 1 authorities = %v, want only %s", one.Authorities, good.Stash)
+	}
+	if two.StartBlock != 200 || two.Finalized || two.AuthorityChanges != 1 {
+		t.Errorf("epoch 2 = %+v, want started at the earliest report and one change", two)
+	}
+	if len(two.Authorities) != 2 || two.Authorities[0] != good.Stash || two.Authorities[1] != bad.Stash {
+		t.Errorf("epoch 2 authorities = %v, want both validators sorted", two.Authorities)
+	}
+	if three.StartBlock != 300 || three.AuthorityChanges != 0 {
+		t.Errorf("epoch 3 = %+v", three)
+	}
+}
+
+func TestConsensusParticipation(t *testing.T) {
+	validator := NewValidator("5F3sa2TJc...Good", ValidatorTypeGood, "")
+	for _, event := range []Event{
+		epochStarted(300, 3),
+		epochStarted(100, 1),
+		epochStarted(301, 3),
+		epochFinalized(190, 1),
+		authoritiesChanged(250),
+		*NewEvent(120, "imOnline.HeartbeatReceived", map[string]interface{}{"author": "5F3sa2TJc...Good"}),
+		*NewEvent(121, "imOnline.HeartbeatReceived", map[string]interface{}{"author": "5HGjWAeFD...Bad"}),
+	} {
+		validator.AddEvent(event)
+	}
+
+	participation := validator.ConsensusParticipation()
+
+	if participation.EpochsInAuthoritySet != 2 {
+		t.Errorf("epochs in authority set = %d, want 2 (epoch 3 reported twice)", participation.EpochsInAuthoritySet)
+	}
+	if participation.FirstEpoch == nil || *participation.FirstEpoch != 1 || participation.LastEpoch == nil || *participation.LastEpoch != 3 {
+		t.Errorf("epochs span %v-%v, want 1-3", participation.FirstEpoch, participation.LastEpoch)
+	}
+	if participation.LastEpochBlock != 300 {
+		t.Errorf("last epoch block = %d, want the first report at 300", participation.LastEpochBlock)
+	}
+	if participation.EpochsFinalized != 1 || participation.AuthoritySetChanges != 1 || participation.BlocksAuthored != 1 {
+		t.Errorf("participation = %+v", participation)
+	}
+
+	empty := NewValidator("5DAAnrj7V...Neutral", ValidatorTypeNeutral, "").ConsensusParticipation()
+	if empty.FirstEpoch != nil || empty.LastEpoch != nil || empty.EpochsInAuthoritySet != 0 {
+		t.Errorf("participation without epochs = %+v", empty)
+	}
+}
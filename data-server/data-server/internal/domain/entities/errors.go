@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+
+	"data-server/internal/domain/domainerr"
+)
+
+// maxAddressLength is the maximum length of an account address
+const maxAddressLength = 64
+
+var (
+	// ErrValidatorNotFound is returned when a validator does not exist
+	ErrValidatorNotFound = domainerr.New(domainerr.NotFound, "validator_not_found", "validator not found")
+
+	// ErrInvalidAddress is returned when an account address is malformed
+	ErrInvalidAddress = domainerr.New(domainerr.InvalidAddress, "invalid_address", "invalid address")
+
+	// ErrInvalidEventQuery is returned when an event query criterion is malformed
+	ErrInvalidEventQuery = domainerr.New(domainerr.InvalidInput, "invalid_event_query", "invalid event query")
+
+	// ErrInvalidTimeRange is returned when a time range is inverted
+	ErrInvalidTimeRange = domainerr.New(domainerr.InvalidRange, "invalid_time_range", "invalid time range")
+
+	// ErrEventsRewritten is returned when a validator is saved with stored events modified
+	// or removed, the stored events of a validator are only ever appended to
+	ErrEventsRewritten = domainerr.New(domainerr.Conflict, "events_rewritten", "stored events cannot be modified or removed")
+
+	// ErrStorageUnavailable is returned when the storage of a repository cannot be written
+	ErrStorageUnavailable = domainerr.New(domainerr.Unavailable, "storage_unavailable", "storage unavailable")
+
+	// ErrStreamInterrupted is returned when an event stream ends before its client
+	// cancels it, because the client fell too far behind the event bus or the server is
+	// shutting down, and the client must resume from its last event
+	ErrStreamInterrupted = domainerr.New(domainerr.Unavailable, "stream_interrupted", "event stream interrupted, resume from the last event id")
+)
+
+// ValidateAddress returns ErrInvalidAddress unless the address is made of ASCII letters
+// and digits, as SS58 and hex addresses are. The abbreviated addresses of the sample
+// data, such as "5F3sa2TJc...Good", are accepted
+func ValidateAddress(address string) error {
+	if address == "" {
+		return fmt.Errorf("%w: address is empty", ErrInvalidAddress)
+	}
+	if len(address) > maxAddressLength {
+		return fmt.Errorf("%w: address is longer than %d characters", ErrInvalidAddress, maxAddressLength)
+	}
+
+	for _, part := range strings.Split(address, "...") {
+		for _, r := range part {
+			if (r < '0' || r > '9') && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+				return fmt.Errorf("%w: unexpected character %q", ErrInvalidAddress, r)
+			}
+		}
+	}
+	return nil
+}
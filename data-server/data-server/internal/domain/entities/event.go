@@ -2,11 +2,14 @@ package entities
 
 import (
 	"time"
+
+	"data-server/internal/domain/valueobjects"
 )
 
 // Event represents a blockchain event
 type Event struct {
 	Block     int         `json:"block"`
+	Index     int         `json:"index"`
 	Event     string      `json:"event"`
 	Data      interface{} `json:"data"`
 	Timestamp time.Time   `json:"timestamp"`
@@ -34,6 +37,11 @@ func NewEventWithHash(block int, eventType string, data interface{}, hash string
 	}
 }
 
+// Position returns the stable position of the event, ordered by block and event index
+func (e Event) Position() valueobjects.CursorKey {
+	return valueobjects.CursorKey{Block: e.Block, Index: e.Index}
+}
+
 // IsStakingEvent returns true if the event is a staking-related event
 func (e *Event) IsStakingEvent() bool {
 	return e.Event == "staking.Bonded" ||
@@ -78,6 +86,10 @@ func (e *Event) GetEventCategory() string {
 		return "online"
 	case e.IsOffenceEvent():
 		return "offence"
+	case e.IsExtrinsicEvent():
+		return "extrinsic"
+	case e.IsConsensusEvent():
+		return "consensus"
 	default:
 		return "other"
 	}
@@ -85,12 +97,87 @@ func (e *Event) GetEventCategory() string {
 
 // GetAmount returns the amount from the event data if available
 func (e *Event) GetAmount() (int64, bool) {
+	return e.GetIntField("amount")
+}
+
+// GetIntField returns a numeric field from the event data if available
+func (e *Event) GetIntField(key string) (int64, bool) {
+	data, ok := e.Data.(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	switch value := data[key].(type) {
+	case int:
+		return int64(value), true
+	case int32:
+		return int64(value), true
+	case int64:
+		return value, true
+	case uint32:
+		return int64(value), true
+	case uint64:
+		return int64(value), true
+	case float64:
+		return int64(value), true
+	}
+	return 0, false
+}
+
+// GetStringListField returns a list of strings from the event data if available
+func (e *Event) GetStringListField(key string) []string {
+	data, ok := e.Data.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	switch values := data[key].(type) {
+	case []string:
+		return values
+	case []interface{}:
+		var result []string
+		for _, value := range values {
+			if str, ok := value.(string); ok {
+				result = append(result, str)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// GetOffenders returns the accounts listed in the offender field of an offence event
+func (e *Event) GetOffenders() []string {
+	data, ok := e.Data.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var offenders []string
+	switch values := data["offender"].(type) {
+	case []map[string]interface{}:
+		for _, offender := range values {
+			if who, ok := offender["who"].(string); ok {
+				offenders = append(offenders, who)
+			}
+		}
+	case []interface{}:
+		for _, value := range values {
+			if offender, ok := value.(map[string]interface{}); ok {
+				if who, ok := offender["who"].(string); ok {
+					offenders = append(offenders, who)
+				}
+			}
+		}
+	}
+	return offenders
+}
+
+// GetStringField returns a string field from the event data if available
+func (e *Event) GetStringField(key string) (string, bool) {
 	if data, ok := e.Data.(map[string]interface{}); ok {
-		if amount, ok := data["amount"].(int64); ok {
-			return amount, true
+		if value, ok := data[key].(string); ok {
+			return value, true
 		}
 	}
-	return 0, false
+	return "", false
 }
 
 // GetStash returns the stash address from the event data if available
@@ -0,0 +1,229 @@
+package entities
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"data-server/internal/domain/valueobjects"
+)
+
+// PredicateOperator represents the comparison applied by a payload predicate
+type PredicateOperator string
+
+const (
+	PredicateEqual          PredicateOperator = "eq"
+	PredicateNotEqual       PredicateOperator = "ne"
+	PredicateGreater        PredicateOperator = "gt"
+	PredicateGreaterOrEqual PredicateOperator = "gte"
+	PredicateLess           PredicateOperator = "lt"
+	PredicateLessOrEqual    PredicateOperator = "lte"
+	PredicateExists         PredicateOperator = "exists"
+)
+
+// PayloadPredicate represents a condition on a field of the event payload. Field is a
+// dot separated path into the event data, e.g. "vote.Standard.vote"
+type PayloadPredicate struct {
+	Field    string            `json:"field"`
+	Operator PredicateOperator `json:"operator"`
+	Value    string            `json:"value,omitempty"`
+}
+
+// ParsePayloadPredicate parses a predicate written as field:operator:value, or
+// field:exists for presence checks
+func ParsePayloadPredicate(expression string) (PayloadPredicate, error) {
+	parts := strings.SplitN(expression, ":", 3)
+	if len(parts) < 2 || parts[0] == "" {
+		return PayloadPredicate{}, fmt.Errorf("%w: predicate %q must be written as field:operator:value", ErrInvalidEventQuery, expression)
+	}
+
+	predicate := PayloadPredicate{
+		Field:    parts[0],
+		Operator: PredicateOperator(parts[1]),
+	}
+	if len(parts) == 3 {
+		predicate.Value = parts[2]
+	}
+
+	switch predicate.Operator {
+	case PredicateExists:
+	case PredicateEqual, PredicateNotEqual, PredicateGreater, PredicateGreaterOrEqual, PredicateLess, PredicateLessOrEqual:
+		if len(parts) != 3 {
+			return PayloadPredicate{}, fmt.Errorf("%w: predicate %q is missing a value", ErrInvalidEventQuery, expression)
+		}
+	default:
+		return PayloadPredicate{}, fmt.Errorf("%w: predicate %q has unknown operator %q", ErrInvalidEventQuery, expression, parts[1])
+	}
+
+	return predicate, nil
+}
+
+// Matches returns true if the event payload satisfies the predicate
+func (p PayloadPredicate) Matches(e Event) bool {
+	value, found := e.GetField(p.Field)
+	if p.Operator == PredicateExists {
+		return found
+	}
+	if !found {
+		return p.Operator == PredicateNotEqual
+	}
+
+	actual, actualIsNumber := toFloat(value)
+	expected, err := strconv.ParseFloat(p.Value, 64)
+	if actualIsNumber && err == nil {
+		switch p.Operator {
+		case PredicateEqual:
+			return actual == expected
+		case PredicateNotEqual:
+			return actual != expected
+		case PredicateGreater:
+			return actual > expected
+		case PredicateGreaterOrEqual:
+			return actual >= expected
+		case PredicateLess:
+			return actual < expected
+		case PredicateLessOrEqual:
+			return actual <= expected
+		}
+		return false
+	}
+
+	text := fmt.Sprint(value)
+	switch p.Operator {
+	case PredicateEqual:
+		return text == p.Value
+	case PredicateNotEqual:
+		return text != p.Value
+	case PredicateGreater:
+		return text > p.Value
+	case PredicateGreaterOrEqual:
+		return text >= p.Value
+	case PredicateLess:
+		return text < p.Value
+	case PredicateLessOrEqual:
+		return text <= p.Value
+	}
+	return false
+}
+
+// EventMatcher is implemented by compiled filter expressions evaluated against events
+type EventMatcher interface {
+	Matches(ctx context.Context, e Event) (bool, error)
+	String() string
+}
+
+// EventQuery represents a composable set of event filters. Every non empty criterion
+// must match; values within a criterion are alternatives
+type EventQuery struct {
+	Types      []string                 `json:"types,omitempty"`
+	Categories []string                 `json:"categories,omitempty"`
+	Stashes    []string                 `json:"stashes,omitempty"`
+	BlockRange *valueobjects.BlockRange `json:"block_range,omitempty"`
+	From       *time.Time               `json:"from,omitempty"`
+	To         *time.Time               `json:"to,omitempty"`
+	Predicates []PayloadPredicate       `json:"predicates,omitempty"`
+	Filter     EventMatcher             `json:"-"`
+}
+
+// Validate returns an error if the query criteria are inconsistent: an inverted block
+// or time range, or a malformed stash address
+func (q *EventQuery) Validate() error {
+	if q.BlockRange != nil {
+		if _, err := valueobjects.NewBlockRange(q.BlockRange.StartBlock, q.BlockRange.EndBlock); err != nil {
+			return err
+		}
+	}
+	if q.From != nil && q.To != nil && q.From.After(*q.To) {
+		return fmt.Errorf("%w: from cannot be after to", ErrInvalidTimeRange)
+	}
+	for _, stash := range q.Stashes {
+		if err := ValidateAddress(stash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MatchesStash returns true if events of the validator with the given stash can match
+func (q *EventQuery) MatchesStash(stash string) bool {
+	return len(q.Stashes) == 0 || containsString(q.Stashes, stash)
+}
+
+// Matches returns true if the event, owned by the validator with the given stash,
+// satisfies every criterion of the query. An error is returned when the filter
+// expression cannot be evaluated, e.g. because it exceeded its cost limit
+func (q *EventQuery) Matches(ctx context.Context, stash string, e Event) (bool, error) {
+	if !q.matchesCriteria(stash, e) {
+		return false, nil
+	}
+	if q.Filter == nil {
+		return true, nil
+	}
+	return q.Filter.Matches(ctx, e)
+}
+
+// matchesCriteria returns true if the event satisfies every criterion but the filter
+func (q *EventQuery) matchesCriteria(stash string, e Event) bool {
+	if !q.MatchesStash(stash) {
+		return false
+	}
+	if len(q.Types) > 0 && !containsString(q.Types, e.Event) {
+		return false
+	}
+	if len(q.Categories) > 0 && !containsString(q.Categories, e.GetEventCategory()) {
+		return false
+	}
+	if q.BlockRange != nil && !q.BlockRange.Contains(e.Block) {
+		return false
+	}
+	if q.From != nil && e.Timestamp.Before(*q.From) {
+		return false
+	}
+	if q.To != nil && e.Timestamp.After(*q.To) {
+		return false
+	}
+	for _, predicate := range q.Predicates {
+		if !predicate.Matches(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetField returns the value at a dot separated path into the event data
+func (e *Event) GetField(path string) (interface{}, bool) {
+	var current interface{} = e.Data
+	for _, key := range strings.Split(path, ".") {
+		data, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = data[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// toFloat converts numeric payload values to float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
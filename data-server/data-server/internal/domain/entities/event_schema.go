@@ -0,0 +1,108 @@
+package entities
+
+import "strings"
+
+// EventFieldType represents the type of a field of the event data
+type EventFieldType string
+
+const (
+	EventFieldString  EventFieldType = "string"
+	EventFieldInteger EventFieldType = "integer"
+	EventFieldBoolean EventFieldType = "boolean"
+	// EventFieldJSON is used for lists and objects without a fixed layout
+	EventFieldJSON EventFieldType = "json"
+)
+
+// EventField represents a field of the event data, nested fields are separated by dots
+type EventField struct {
+	Path string         `json:"path"`
+	Type EventFieldType `json:"type"`
+}
+
+// eventSchemas are the known fields of the event data by event type
+var eventSchemas = map[string][]EventField{
+	"babe.AuthoritiesChanged":            {},
+	"babe.EpochFinalized":                {{"epoch_index", EventFieldInteger}},
+	"babe.EpochStarted":                  {{"epoch_index", EventFieldInteger}},
+	"democracy.Cancelled":                {{"ref_index", EventFieldInteger}},
+	"democracy.ExternalTabled":           {},
+	"democracy.NotPassed":                {{"referendum_index", EventFieldInteger}},
+	"democracy.Passed":                   {{"ref_index", EventFieldInteger}},
+	"democracy.Proposed":                 {{"proposal_index", EventFieldInteger}, {"deposit", EventFieldInteger}},
+	"democracy.Seconded":                 {{"seconder", EventFieldString}, {"proposal_index", EventFieldInteger}},
+	"democracy.Started":                  {{"referendum_index", EventFieldInteger}, {"threshold", EventFieldString}},
+	"democracy.Tabled":                   {{"proposal_index", EventFieldInteger}},
+	"democracy.Voted":                    {{"voter", EventFieldString}, {"ref_index", EventFieldInteger}, {"vote", EventFieldJSON}},
+	"imOnline.AllGood":                   {},
+	"imOnline.HeartbeatReceived":         {{"authority_id", EventFieldString}},
+	"imOnline.SomeOffline":               {{"authority_ids", EventFieldJSON}},
+	"offences.Offence":                   {{"kind", EventFieldString}, {"offender", EventFieldJSON}},
+	"referenda.Cancelled":                {{"referendum_index", EventFieldInteger}},
+	"referenda.Confirmed":                {{"referendum_index", EventFieldInteger}},
+	"referenda.DecisionDepositPlaced":    {{"referendum_index", EventFieldInteger}, {"who", EventFieldString}, {"amount", EventFieldInteger}},
+	"referenda.DecisionStarted":          {{"referendum_index", EventFieldInteger}, {"track", EventFieldInteger}, {"conviction", EventFieldString}},
+	"referenda.Killed":                   {{"referendum_index", EventFieldInteger}},
+	"referenda.Rejected":                 {{"referendum_index", EventFieldInteger}},
+	"referenda.Submitted":                {{"referendum_index", EventFieldInteger}, {"proposal_hash", EventFieldString}},
+	"referenda.TimedOut":                 {{"referendum_index", EventFieldInteger}},
+	"session.NewSession":                 {{"session_index", EventFieldInteger}},
+	"session.ValidatorDisabled":          {{"who", EventFieldString}},
+	"staking.Bonded":                     {{"stash", EventFieldString}, {"amount", EventFieldInteger}},
+	"staking.Chilled":                    {{"stash", EventFieldString}},
+	"staking.EraPaid":                    {{"era_index", EventFieldInteger}, {"validator_payout", EventFieldInteger}, {"remainder", EventFieldInteger}},
+	"staking.Kicked":                     {{"nominator", EventFieldString}, {"stash", EventFieldString}},
+	"staking.OldSlashingReportDiscarded": {{"session_index", EventFieldInteger}},
+	"staking.PayoutStarted":              {{"era_index", EventFieldInteger}, {"validator_stash", EventFieldString}, {"page", EventFieldInteger}, {"next", EventFieldInteger}},
+	"staking.Rewarded":                   {{"stash", EventFieldString}, {"dest", EventFieldString}, {"amount", EventFieldInteger}},
+	"staking.SlashReported":              {{"validator", EventFieldString}, {"fraction", EventFieldString}, {"slash_era", EventFieldInteger}},
+	"staking.Slashed":                    {{"staker", EventFieldString}, {"amount", EventFieldInteger}},
+	"staking.StakersElected":             {},
+	"staking.Unbonded":                   {{"stash", EventFieldString}, {"amount", EventFieldInteger}},
+	"staking.ValidatorPrefsSet":          {{"stash", EventFieldString}, {"prefs.commission", EventFieldInteger}, {"prefs.blocked", EventFieldBoolean}},
+	"staking.Withdrawn":                  {{"stash", EventFieldString}, {"amount", EventFieldInteger}},
+	"system.ExtrinsicFailed":             {{"dispatch_error.module", EventFieldString}, {"dispatch_error.error", EventFieldString}, {"dispatch_info.weight", EventFieldInteger}, {"dispatch_info.class", EventFieldString}, {"dispatch_info.pays_fee", EventFieldBoolean}},
+	"system.ExtrinsicSuccess":            {{"dispatch_info.weight", EventFieldInteger}, {"dispatch_info.class", EventFieldString}, {"dispatch_info.pays_fee", EventFieldBoolean}},
+	"system.KilledAccount":               {{"account", EventFieldString}},
+	"system.NewAccount":                  {{"account", EventFieldString}},
+	"system.Remarked":                    {{"sender", EventFieldString}, {"hash", EventFieldString}},
+}
+
+// EventSchema returns the known fields of the data of the given event types, in order
+// of first appearance. It returns false if no type is given or a type is unknown
+func EventSchema(eventTypes []string) ([]EventField, bool) {
+	if len(eventTypes) == 0 {
+		return nil, false
+	}
+
+	seen := map[string]bool{}
+	fields := []EventField{}
+	for _, eventType := range eventTypes {
+		schema, ok := eventSchemas[eventType]
+		if !ok {
+			return nil, false
+		}
+		for _, field := range schema {
+			if !seen[field.Path] {
+				seen[field.Path] = true
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	return fields, true
+}
+
+// Field returns the value of a field of the event data, nested fields are separated by dots
+func (e *Event) Field(path string) (interface{}, bool) {
+	var value interface{} = e.Data
+	for _, key := range strings.Split(path, ".") {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = data[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, value != nil
+}
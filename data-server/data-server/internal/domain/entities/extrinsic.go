@@ -0,0 +1,231 @@
+package entities
+
+import (
+	"sort"
+
+	"data-server/internal/domain/valueobjects"
+)
+
+// DefaultExtrinsicTrendBucket is the number of blocks covered by a failure trend bucket
+const DefaultExtrinsicTrendBucket = 100
+
+// failureStreakSaturation is the consecutive failure streak at which the streak part
+// of the failure score reaches its maximum
+const failureStreakSaturation = 5
+
+// IsExtrinsicEvent returns true if the event reports the outcome of an extrinsic
+func (e *Event) IsExtrinsicEvent() bool {
+	return e.Event == "system.ExtrinsicSuccess" ||
+		e.Event == "system.ExtrinsicFailed"
+}
+
+// GetDispatchError returns the module and error of a failed extrinsic if available
+func (e *Event) GetDispatchError() (string, string, bool) {
+	data, ok := e.Data.(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+	dispatchError, ok := data["dispatch_error"].(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+	module, _ := dispatchError["module"].(string)
+	err, _ := dispatchError["error"].(string)
+	return module, err, module != "" || err != ""
+}
+
+// GetDispatchWeight returns the weight of an extrinsic if available
+func (e *Event) GetDispatchWeight() (int64, bool) {
+	data, ok := e.Data.(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	info := &Event{Data: data["dispatch_info"]}
+	return info.GetIntField("weight")
+}
+
+// ExtrinsicErrorCount represents how often a module/error pair caused a failure
+type ExtrinsicErrorCount struct {
+	Module       string `json:"module"`
+	Error        string `json:"error"`
+	Count        int    `json:"count"`
+	WastedWeight int64  `json:"wasted_weight"`
+}
+
+// ExtrinsicTrendBucket represents extrinsic outcomes within a range of blocks
+type ExtrinsicTrendBucket struct {
+	StartBlock   int     `json:"start_block"`
+	EndBlock     int     `json:"end_block"`
+	Successes    int     `json:"successes"`
+	Failures     int     `json:"failures"`
+	FailureRate  float64 `json:"failure_rate"`
+	WastedWeight int64   `json:"wasted_weight"`
+}
+
+// AccountExtrinsicFailures represents extrinsic failure analytics for a single account
+type AccountExtrinsicFailures struct {
+	Account       string                 `json:"account"`
+	Successes     int                    `json:"successes"`
+	Failures      int                    `json:"failures"`
+	FailureRate   float64                `json:"failure_rate"`
+	WastedWeight  int64                  `json:"wasted_weight"`
+	LongestStreak int                    `json:"longest_failure_streak"`
+	Score         float64                `json:"score"`
+	TopErrors     []ExtrinsicErrorCount  `json:"top_errors"`
+	Trend         []ExtrinsicTrendBucket `json:"trend"`
+}
+
+// ExtrinsicFailureReport represents extrinsic failure analytics across accounts
+type ExtrinsicFailureReport struct {
+	BucketSize   int                        `json:"bucket_size"`
+	Successes    int                        `json:"successes"`
+	Failures     int                        `json:"failures"`
+	FailureRate  float64                    `json:"failure_rate"`
+	WastedWeight int64                      `json:"wasted_weight"`
+	TopErrors    []ExtrinsicErrorCount      `json:"top_errors"`
+	Accounts     []AccountExtrinsicFailures `json:"accounts"`
+}
+
+// ExtrinsicFailures computes the extrinsic failure analytics of the validator account
+// within the given block range, bucketing the trend by bucketSize blocks. The score
+// ranges from 0 to 100 and combines the failure rate with the longest failure streak
+func (v *Validator) ExtrinsicFailures(blockRange *valueobjects.BlockRange, bucketSize int) AccountExtrinsicFailures {
+	if bucketSize <= 0 {
+		bucketSize = DefaultExtrinsicTrendBucket
+	}
+
+	result := AccountExtrinsicFailures{
+		Account:   v.Stash,
+		TopErrors: []ExtrinsicErrorCount{},
+		Trend:     []ExtrinsicTrendBucket{},
+	}
+
+	errors := map[[2]string]*ExtrinsicErrorCount{}
+	buckets := map[int]*ExtrinsicTrendBucket{}
+	streak := 0
+
+	for _, event := range v.SortedEvents() {
+		if !event.IsExtrinsicEvent() {
+			continue
+		}
+		if blockRange != nil && !blockRange.Contains(event.Block) {
+			continue
+		}
+
+		start := event.Block - event.Block%bucketSize
+		bucket, exists := buckets[start]
+		if !exists {
+			bucket = &ExtrinsicTrendBucket{StartBlock: start, EndBlock: start + bucketSize - 1}
+			buckets[start] = bucket
+		}
+
+		if event.Event == "system.ExtrinsicSuccess" {
+			result.Successes++
+			bucket.Successes++
+			streak = 0
+			continue
+		}
+
+		weight, _ := event.GetDispatchWeight()
+		result.Failures++
+		result.WastedWeight += weight
+		bucket.Failures++
+		bucket.WastedWeight += weight
+		streak++
+		if streak > result.LongestStreak {
+			result.LongestStreak = streak
+		}
+
+		module, err, _ := event.GetDispatchError()
+		key := [2]string{module, err}
+		count, exists := errors[key]
+		if !exists {
+			count = &ExtrinsicErrorCount{Module: module, Error: err}
+			errors[key] = count
+		}
+		count.Count++
+		count.WastedWeight += weight
+	}
+
+	result.FailureRate = failureRate(result.Successes, result.Failures)
+	result.TopErrors = sortErrorCounts(errors)
+
+	starts := make([]int, 0, len(buckets))
+	for start := range buckets {
+		starts = append(starts, start)
+	}
+	sort.Ints(starts)
+	for _, start := range starts {
+		bucket := buckets[start]
+		bucket.FailureRate = failureRate(bucket.Successes, bucket.Failures)
+		result.Trend = append(result.Trend, *bucket)
+	}
+
+	streakFactor := float64(result.LongestStreak) / failureStreakSaturation
+	if streakFactor > 1 {
+		streakFactor = 1
+	}
+	result.Score = 100 * (0.7*result.FailureRate + 0.3*streakFactor)
+
+	return result
+}
+
+// NewExtrinsicFailureReport aggregates account failure analytics into a report
+func NewExtrinsicFailureReport(accounts []AccountExtrinsicFailures, bucketSize int) *ExtrinsicFailureReport {
+	report := &ExtrinsicFailureReport{
+		BucketSize: bucketSize,
+		TopErrors:  []ExtrinsicErrorCount{},
+		Accounts:   accounts,
+	}
+
+	errors := map[[2]string]*ExtrinsicErrorCount{}
+	for _, account := range accounts {
+		report.Successes += account.Successes
+		report.Failures += account.Failures
+		report.WastedWeight += account.WastedWeight
+		for _, top := range account.TopErrors {
+			key := [2]string{top.Module, top.Error}
+			count, exists := errors[key]
+			if !exists {
+				count = &ExtrinsicErrorCount{Module: top.Module, Error: top.Error}
+				errors[key] = count
+			}
+			count.Count += top.Count
+			count.WastedWeight += top.WastedWeight
+		}
+	}
+	report.FailureRate = failureRate(report.Successes, report.Failures)
+	report.TopErrors = sortErrorCounts(errors)
+
+	sort.SliceStable(report.Accounts, func(i, j int) bool {
+		return report.Accounts[i].Score > report.Accounts[j].Score
+	})
+
+	return report
+}
+
+// failureRate returns the share of failed extrinsics
+func failureRate(successes, failures int) float64 {
+	if successes+failures == 0 {
+		return 0
+	}
+	return float64(failures) / float64(successes+failures)
+}
+
+// sortErrorCounts returns the error counts ordered by count, then module and error
+func sortErrorCounts(errors map[[2]string]*ExtrinsicErrorCount) []ExtrinsicErrorCount {
+	counts := make([]ExtrinsicErrorCount, 0, len(errors))
+	for _, count := range errors {
+		counts = append(counts, *count)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		if counts[i].Module != counts[j].Module {
+			return counts[i].Module < counts[j].Module
+		}
+		return counts[i].Error < counts[j].Error
+	})
+	return counts
+}
@@ -0,0 +1,106 @@
+package entities
+
+import (
+	"math"
+	"testing"
+
+	"data-server/internal/domain/valueobjects"
+)
+
+func extrinsicSuccess(block int) Event {
+	return *NewEvent(block, "system.ExtrinsicSuccess", map[string]interface{}{"dispatch_info": map[string]interface{}{"weight": 10}})
+}
+
+func extrinsicFailed(block int, module, err string, weight int) Event {
+	return *NewEvent(block, "system.ExtrinsicFailed", map[string]interface{}{
+		"dispatch_error": map[string]interface{}{"module": module, "error": err},
+		"dispatch_info":  map[string]interface{}{"weight": weight},
+	})
+}
+
+func TestExtrinsicFailures(t *testing.T) {
+	validator := NewValidator("5HGjWAeFD...Bad", ValidatorTypeBad, "")
+	for _, event := range []Event{
+		extrinsicSuccess(10),
+		extrinsicFailed(20, "Staking", "NotController", 100),
+		extrinsicFailed(30, "Staking", "NotController", 100),
+		extrinsicFailed(40, "Balances", "InsufficientBalance", 50),
+		extrinsicSuccess(110),
+		extrinsicFailed(120, "Staking", "NotController", 100),
+		*NewEvent(130, "staking.Rewarded", map[string]interface{}{"stash": "5HGjWAeFD...Bad", "amount": 1}),
+	} {
+		validator.AddEvent(event)
+	}
+
+	result := validator.ExtrinsicFailures(nil, 100)
+
+	if result.Successes != 2 || result.Failures != 4 || result.WastedWeight != 350 {
+		t.Errorf("got %d successes, %d failures, %d wasted weight, want 2, 4, 350", result.Successes, result.Failures, result.WastedWeight)
+	}
+	if result.LongestStreak != 3 {
+		t.Errorf("longest failure streak = %d, want 3", result.LongestStreak)
+	}
+	if result.FailureRate != 4.0/6.0 {
+		t.Errorf("failure rate = %v, want %v", result.FailureRate, 4.0/6.0)
+	}
+	// 70% of the failure rate and 30% of the streak relative to its saturation
+	if want := 100 * (0.7*4.0/6.0 + 0.3*3.0/5.0); math.Abs(result.Score-want) > 1e-9 {
+		t.Errorf("score = %v, want %v", result.Score, want)
+	}
+
+	if len(result.TopErrors) != 2 {
+		t.Fatalf("top errors = %+v, want 2", result.TopErrors)
+	}
+	if top := result.TopErrors[0]; top.Module != "Staking" || top.Error != "NotController" || top.Count != 3 || top.WastedWeight != 300 {
+		t.Errorf("top error = %+v, want Staking.NotController 3 times", top)
+	}
+
+	if len(result.Trend) != 2 {
+		t.Fatalf("trend = %+v, want 2 buckets", result.Trend)
+	}
+	if bucket := result.Trend[0]; bucket.StartBlock != 0 || bucket.EndBlock != 99 || bucket.Successes != 1 || bucket.Failures != 3 {
+		t.Errorf("first bucket = %+v", bucket)
+	}
+	if bucket := result.Trend[1]; bucket.StartBlock != 100 || bucket.Failures != 1 || bucket.FailureRate != 0.5 {
+		t.Errorf("second bucket = %+v", bucket)
+	}
+
+	blockRange, err := valueobjects.NewBlockRange(100, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ranged := validator.ExtrinsicFailures(blockRange, 100)
+	if ranged.Successes != 1 || ranged.Failures != 1 || ranged.LongestStreak != 1 {
+		t.Errorf("within %d-%d got %d successes, %d failures, streak %d, want 1, 1, 1",
+			blockRange.StartBlock, blockRange.EndBlock, ranged.Successes, ranged.Failures, ranged.LongestStreak)
+	}
+}
+
+func TestNewExtrinsicFailureReportMergesAccounts(t *testing.T) {
+	low := AccountExtrinsicFailures{
+		Account: "low", Successes: 9, Failures: 1, WastedWeight: 10, Score: 7,
+		TopErrors: []ExtrinsicErrorCount{{Module: "Staking", Error: "NotController", Count: 1, WastedWeight: 10}},
+	}
+	high := AccountExtrinsicFailures{
+		Account: "high", Successes: 0, Failures: 2, WastedWeight: 30, Score: 82,
+		TopErrors: []ExtrinsicErrorCount{
+			{Module: "Balances", Error: "InsufficientBalance", Count: 1, WastedWeight: 20},
+			{Module: "Staking", Error: "NotController", Count: 1, WastedWeight: 10},
+		},
+	}
+
+	report := NewExtrinsicFailureReport([]AccountExtrinsicFailures{low, high}, 100)
+
+	if report.Successes != 9 || report.Failures != 3 || report.WastedWeight != 40 {
+		t.Errorf("report totals = %d/%d/%d, want 9/3/40", report.Successes, report.Failures, report.WastedWeight)
+	}
+	if report.FailureRate != 0.25 {
+		t.Errorf("failure rate = %v, want 0.25", report.FailureRate)
+	}
+	if report.Accounts[0].Account != "high" {
+		t.Errorf("accounts are not ordered by score: %s first", report.Accounts[0].Account)
+	}
+	if top := report.TopErrors[0]; top.Module != "Staking" || top.Count != 2 || top.WastedWeight != 20 {
+		t.Errorf("top error = %+v, want Staking.NotController twice", top)
+	}
+}
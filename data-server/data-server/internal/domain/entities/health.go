@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"time"
+
+	"data-server/internal/domain/domainerr"
+)
+
+// ErrIngestionLagging is returned when the stored data trails the chain head by more
+// blocks than allowed
+var ErrIngestionLagging = domainerr.New(domainerr.Unavailable, "ingestion_lagging", "ingestion lagging behind the chain")
+
+// ErrShuttingDown is returned when the server is shutting down and no longer accepts
+// new work
+var ErrShuttingDown = domainerr.New(domainerr.Unavailable, "shutting_down", "server shutting down")
+
+// HealthStatus represents the health of the server or of one of its dependencies
+type HealthStatus string
+
+const (
+	// HealthHealthy means every check passed
+	HealthHealthy HealthStatus = "healthy"
+	// HealthDegraded means a non critical check failed, the server still serves requests
+	HealthDegraded HealthStatus = "degraded"
+	// HealthUnhealthy means a critical check failed, the server should not receive
+	// requests
+	HealthUnhealthy HealthStatus = "unhealthy"
+)
+
+// HealthCheck represents the outcome of a health check. Failed critical checks make the
+// server unhealthy, other failed checks only degrade it
+type HealthCheck struct {
+	Name       string       `json:"name"`
+	Status     HealthStatus `json:"status"`
+	Critical   bool         `json:"critical"`
+	Message    string       `json:"message,omitempty"`
+	DurationMs float64      `json:"duration_ms"`
+}
+
+// HealthReport represents the health of the server, the worst status of its checks
+type HealthReport struct {
+	Status    HealthStatus  `json:"status"`
+	Checks    []HealthCheck `json:"checks"`
+	CheckedAt time.Time     `json:"checked_at"`
+}
+
+// NewHealthReport returns the report of the given checks
+func NewHealthReport(checks []HealthCheck, checkedAt time.Time) *HealthReport {
+	report := &HealthReport{
+		Status:    HealthHealthy,
+		Checks:    checks,
+		CheckedAt: checkedAt,
+	}
+	for _, check := range checks {
+		switch {
+		case check.Status == HealthUnhealthy:
+			report.Status = HealthUnhealthy
+		case check.Status == HealthDegraded && report.Status == HealthHealthy:
+			report.Status = HealthDegraded
+		}
+	}
+	return report
+}
+
+// Ready returns true if the server can serve requests, possibly degraded
+func (r *HealthReport) Ready() bool {
+	return r.Status != HealthUnhealthy
+}
@@ -0,0 +1,202 @@
+package entities
+
+import (
+	"fmt"
+	"sort"
+
+	"data-server/internal/domain/valueobjects"
+)
+
+// DefaultIncidentWindow is the maximum number of blocks between two related events
+// for them to be grouped into the same incident
+const DefaultIncidentWindow = 50
+
+// IncidentSeverity represents how serious an incident is
+type IncidentSeverity string
+
+const (
+	IncidentSeverityLow      IncidentSeverity = "low"
+	IncidentSeverityMedium   IncidentSeverity = "medium"
+	IncidentSeverityHigh     IncidentSeverity = "high"
+	IncidentSeverityCritical IncidentSeverity = "critical"
+)
+
+// severityRank orders severities from least to most serious
+var severityRank = map[IncidentSeverity]int{
+	IncidentSeverityLow:      1,
+	IncidentSeverityMedium:   2,
+	IncidentSeverityHigh:     3,
+	IncidentSeverityCritical: 4,
+}
+
+// IsValidIncidentSeverity returns true if the given severity is known
+func IsValidIncidentSeverity(severity string) bool {
+	_, ok := severityRank[IncidentSeverity(severity)]
+	return ok
+}
+
+// Incident represents a group of offence, slash, disablement and offline events
+// describing the same underlying problem of a validator
+type Incident struct {
+	ID             string           `json:"id"`
+	Stash          string           `json:"stash"`
+	Severity       IncidentSeverity `json:"severity"`
+	StartBlock     int              `json:"start_block"`
+	EndBlock       int              `json:"end_block"`
+	Eras           []int            `json:"eras"`
+	OffenceKinds   []string         `json:"offence_kinds"`
+	SlashedAmount  int64            `json:"slashed_amount"`
+	SlashReports   int              `json:"slash_reports"`
+	Disablements   int              `json:"disablements"`
+	OfflineReports int              `json:"offline_reports"`
+	Evidence       []Event          `json:"evidence"`
+}
+
+// IsIncidentEvent returns true if the event can be part of an incident
+func (e *Event) IsIncidentEvent() bool {
+	return e.Event == "offences.Offence" ||
+		e.Event == "staking.Slashed" ||
+		e.Event == "staking.SlashReported" ||
+		e.Event == "session.ValidatorDisabled" ||
+		e.Event == "imOnline.SomeOffline"
+}
+
+// Involves returns true if the incident related event names the given stash
+func (e *Event) Involves(stash string) bool {
+	switch e.Event {
+	case "offences.Offence":
+		return containsString(e.GetOffenders(), stash)
+	case "staking.Slashed":
+		staker, ok := e.GetStringField("staker")
+		return ok && staker == stash
+	case "staking.SlashReported":
+		validator, ok := e.GetStringField("validator")
+		return ok && validator == stash
+	case "session.ValidatorDisabled":
+		who, ok := e.GetStringField("who")
+		return ok && who == stash
+	case "imOnline.SomeOffline":
+		return containsString(e.GetStringListField("authority_ids"), stash)
+	}
+	return false
+}
+
+// Incidents groups the offence, slash, disablement and offline events of the validator
+// into incidents. Events belong to the same incident when they are at most window
+// blocks apart or reference an era already covered by the incident
+func (v *Validator) Incidents(window int) []Incident {
+	if window <= 0 {
+		window = DefaultIncidentWindow
+	}
+
+	incidents := []Incident{}
+	var current *Incident
+
+	for _, event := range v.SortedEvents() {
+		if !event.IsIncidentEvent() || !event.Involves(v.Stash) {
+			continue
+		}
+
+		era, hasEra := event.GetIntField("slash_era")
+		related := current != nil &&
+			(event.Block-current.EndBlock <= window || (hasEra && containsInt(current.Eras, int(era))))
+		if !related {
+			if current != nil {
+				incidents = append(incidents, current.finalize())
+			}
+			current = &Incident{
+				ID:           fmt.Sprintf("%s-%d", v.Stash, event.Block),
+				Stash:        v.Stash,
+				StartBlock:   event.Block,
+				Eras:         []int{},
+				OffenceKinds: []string{},
+				Evidence:     []Event{},
+			}
+		}
+
+		current.EndBlock = event.Block
+		current.Evidence = append(current.Evidence, event)
+		if hasEra && !containsInt(current.Eras, int(era)) {
+			current.Eras = append(current.Eras, int(era))
+		}
+
+		switch event.Event {
+		case "offences.Offence":
+			if kind, ok := event.GetStringField("kind"); ok && !containsString(current.OffenceKinds, kind) {
+				current.OffenceKinds = append(current.OffenceKinds, kind)
+			}
+		case "staking.Slashed":
+			if amount, ok := event.GetAmount(); ok {
+				current.SlashedAmount += amount
+			}
+		case "staking.SlashReported":
+			current.SlashReports++
+		case "session.ValidatorDisabled":
+			current.Disablements++
+		case "imOnline.SomeOffline":
+			current.OfflineReports++
+		}
+	}
+
+	if current != nil {
+		incidents = append(incidents, current.finalize())
+	}
+
+	return incidents
+}
+
+// finalize sorts the incident eras and derives its severity
+func (i *Incident) finalize() Incident {
+	sort.Ints(i.Eras)
+
+	severe := false
+	for _, kind := range i.OffenceKinds {
+		if kind != "offline" {
+			severe = true
+		}
+	}
+	slashed := i.SlashedAmount > 0 || i.SlashReports > 0
+
+	switch {
+	case slashed && i.Disablements > 0:
+		i.Severity = IncidentSeverityCritical
+	case slashed || severe:
+		i.Severity = IncidentSeverityHigh
+	case i.Disablements > 0 || len(i.OffenceKinds) > 0:
+		i.Severity = IncidentSeverityMedium
+	default:
+		i.Severity = IncidentSeverityLow
+	}
+
+	return *i
+}
+
+// Position returns the stable position of the incident, ordered by start block
+func (i Incident) Position() valueobjects.CursorKey {
+	return valueobjects.CursorKey{Block: i.StartBlock, ID: i.ID}
+}
+
+// AtLeast returns true if the incident is at least as severe as the given severity
+func (i *Incident) AtLeast(severity IncidentSeverity) bool {
+	return severityRank[i.Severity] >= severityRank[severity]
+}
+
+// containsString returns true if the slice contains the given value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// containsInt returns true if the slice contains the given value
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package entities
+
+import "testing"
+
+const testIncidentStash = "5HGjWAeFD...Bad"
+
+func offence(block int, kind string, offenders ...string) Event {
+	entries := make([]interface{}, 0, len(offenders))
+	for _, offender := range offenders {
+		entries = append(entries, map[string]interface{}{"who": offender})
+	}
+	return *NewEvent(block, "offences.Offence", map[string]interface{}{"kind": kind, "offender": entries})
+}
+
+func TestIncidentsGroupEventsWithinTheWindow(t *testing.T) {
+	validator := NewValidator(testIncidentStash, ValidatorTypeBad, "")
+	validator.AddEvent(offence(100, "babe_equivocation", testIncidentStash))
+	validator.AddEvent(*NewEvent(120, "staking.Slashed", map[string]interface{}{"staker": testIncidentStash, "amount": 700}))
+	validator.AddEvent(*NewEvent(150, "session.ValidatorDisabled", map[string]interface{}{"who": testIncidentStash}))
+	// 51 blocks after the disablement, a new incident
+	validator.AddEvent(*NewEvent(201, "imOnline.SomeOffline", map[string]interface{}{"authority_ids": []interface{}{testIncidentStash}}))
+
+	incidents := validator.Incidents(50)
+
+	if len(incidents) != 2 {
+		t.Fatalf("got %d incidents, want 2: %+v", len(incidents), incidents)
+	}
+
+	first := incidents[0]
+	if first.StartBlock != 100 || first.EndBlock != 150 || len(first.Evidence) != 3 {
+		t.Errorf("first incident spans %d-%d with %d events, want 100-150 with 3", first.StartBlock, first.EndBlock, len(first.Evidence))
+	}
+	if first.SlashedAmount != 700 || first.Disablements != 1 {
+		t.Errorf("first incident slashed %d with %d disablements, want 700 and 1", first.SlashedAmount, first.Disablements)
+	}
+	if first.Severity != IncidentSeverityCritical {
+		t.Errorf("slash and disablement severity = %s, want %s", first.Severity, IncidentSeverityCritical)
+	}
+
+	second := incidents[1]
+	if second.StartBlock != 201 || second.OfflineReports != 1 || second.Severity != IncidentSeverityLow {
+		t.Errorf("second incident = %+v, want a low offline report at 201", second)
+	}
+	if second.ID != testIncidentStash+"-201" {
+		t.Errorf("second incident ID = %s", second.ID)
+	}
+}
+
+func TestIncidentsJoinEventsOfTheSameSlashEra(t *testing.T) {
+	validator := NewValidator(testIncidentStash, ValidatorTypeBad, "")
+	validator.AddEvent(*NewEvent(100, "staking.SlashReported", map[string]interface{}{"validator": testIncidentStash, "slash_era": 7}))
+	// Far outside the window, but reported for the same era
+	validator.AddEvent(*NewEvent(900, "staking.SlashReported", map[string]interface{}{"validator": testIncidentStash, "slash_era": 7}))
+	validator.AddEvent(*NewEvent(2000, "staking.SlashReported", map[string]interface{}{"validator": testIncidentStash, "slash_era": 8}))
+
+	incidents := validator.Incidents(DefaultIncidentWindow)
+
+	if len(incidents) != 2 {
+		t.Fatalf("got %d incidents, want 2: %+v", len(incidents), incidents)
+	}
+	if incidents[0].SlashReports != 2 || len(incidents[0].Eras) != 1 || incidents[0].Eras[0] != 7 {
+		t.Errorf("first incident = %d reports for eras %v, want 2 reports for era 7", incidents[0].SlashReports, incidents[0].Eras)
+	}
+	if incidents[0].Severity != IncidentSeverityHigh {
+		t.Errorf("slash report severity = %s, want %s", incidents[0].Severity, IncidentSeverityHigh)
+	}
+}
+
+func TestIncidentsSkipEventsOfOtherValidators(t *testing.T) {
+	validator := NewValidator(testIncidentStash, ValidatorTypeBad, "")
+	validator.AddEvent(offence(100, "offline", "5F3sa2TJc...Good"))
+	validator.AddEvent(*NewEvent(110, "staking.Slashed", map[string]interface{}{"staker": "5F3sa2TJc...Good", "amount": 5}))
+	validator.AddEvent(offence(120, "offline", "5F3sa2TJc...Good", testIncidentStash))
+
+	incidents := validator.Incidents(DefaultIncidentWindow)
+
+	if len(incidents) != 1 || len(incidents[0].Evidence) != 1 || incidents[0].StartBlock != 120 {
+		t.Fatalf("incidents = %+v, want only the offence naming the validator", incidents)
+	}
+	// An offline offence alone is not severe
+	if incidents[0].Severity != IncidentSeverityMedium {
+		t.Errorf("offline offence severity = %s, want %s", incidents[0].Severity, IncidentSeverityMedium)
+	}
+	if !incidents[0].AtLeast(IncidentSeverityLow) || incidents[0].AtLeast(IncidentSeverityHigh) {
+		t.Errorf("AtLeast() is inconsistent with severity %s", incidents[0].Severity)
+	}
+}
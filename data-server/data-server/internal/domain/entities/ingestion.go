@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"time"
+
+	"data-server/internal/domain/domainerr"
+)
+
+// ErrChainUnavailable is returned when the chain head cannot be read from the node
+var ErrChainUnavailable = domainerr.New(domainerr.Unavailable, "chain_unavailable", "chain node unavailable")
+
+// IngestionStatus represents how far the stored data trails the chain. The chain head is
+// nil when it is unknown
+type IngestionStatus struct {
+	LastStoredBlock int       `json:"last_stored_block"`
+	LastUpdatedAt   time.Time `json:"last_updated_at"`
+	ChainHead       *int      `json:"chain_head,omitempty"`
+}
+
+// Lag returns the number of blocks of the chain not stored yet, and false if the chain
+// head is unknown
+func (s *IngestionStatus) Lag() (int, bool) {
+	if s.ChainHead == nil {
+		return 0, false
+	}
+	if lag := *s.ChainHead - s.LastStoredBlock; lag > 0 {
+		return lag, true
+	}
+	return 0, true
+}
@@ -0,0 +1,150 @@
+package entities
+
+import "sort"
+
+// DefaultRepeatOffenceWindow is the number of sessions within which a second offence
+// marks a validator as a repeat offender
+const DefaultRepeatOffenceWindow = 6
+
+// Offence represents a single offender entry of an offences.Offence event
+type Offence struct {
+	Block    int    `json:"block"`
+	Kind     string `json:"kind"`
+	Offender string `json:"offender"`
+	Session  *int   `json:"session,omitempty"`
+}
+
+// OffenderStats represents offence analytics for a single offender
+type OffenderStats struct {
+	Stash            string         `json:"stash"`
+	TotalOffences    int            `json:"total_offences"`
+	OffencesByKind   map[string]int `json:"offences_by_kind"`
+	FirstBlock       int            `json:"first_block"`
+	LastBlock        int            `json:"last_block"`
+	MaxInWindow      int            `json:"max_in_window"`
+	IsRepeatOffender bool           `json:"is_repeat_offender"`
+}
+
+// OffenceReport represents offence analytics across offenders
+type OffenceReport struct {
+	TotalOffences   int             `json:"total_offences"`
+	OffencesByKind  map[string]int  `json:"offences_by_kind"`
+	SessionWindow   int             `json:"session_window"`
+	RepeatOffenders []string        `json:"repeat_offenders"`
+	Offenders       []OffenderStats `json:"offenders"`
+	Offences        []Offence       `json:"offences"`
+}
+
+// ExtractOffences returns one offence per offender of every offences.Offence event,
+// resolving the session each offence happened in from the given timeline
+func ExtractOffences(events []Event, sessions SessionTimeline) []Offence {
+	offences := []Offence{}
+	for _, event := range events {
+		if !event.IsOffenceEvent() {
+			continue
+		}
+		kind, _ := event.GetStringField("kind")
+		for _, offender := range event.GetOffenders() {
+			offence := Offence{
+				Block:    event.Block,
+				Kind:     kind,
+				Offender: offender,
+			}
+			if session, ok := sessions.SessionAt(event.Block); ok {
+				offence.Session = &session
+			}
+			offences = append(offences, offence)
+		}
+	}
+
+	sort.SliceStable(offences, func(i, j int) bool {
+		return offences[i].Block < offences[j].Block
+	})
+
+	return offences
+}
+
+// AnalyzeOffences counts offences by kind per offender and network-wide and flags
+// offenders with more than one offence within sessionWindow sessions
+func AnalyzeOffences(offences []Offence, sessionWindow int) *OffenceReport {
+	if sessionWindow <= 0 {
+		sessionWindow = DefaultRepeatOffenceWindow
+	}
+
+	report := &OffenceReport{
+		OffencesByKind:  map[string]int{},
+		SessionWindow:   sessionWindow,
+		RepeatOffenders: []string{},
+		Offenders:       []OffenderStats{},
+		Offences:        offences,
+	}
+
+	byOffender := map[string][]Offence{}
+	var order []string
+	for _, offence := range offences {
+		report.TotalOffences++
+		report.OffencesByKind[offence.Kind]++
+		if _, exists := byOffender[offence.Offender]; !exists {
+			order = append(order, offence.Offender)
+		}
+		byOffender[offence.Offender] = append(byOffender[offence.Offender], offence)
+	}
+
+	for _, stash := range order {
+		stats := analyzeOffender(stash, byOffender[stash], sessionWindow)
+		if stats.IsRepeatOffender {
+			report.RepeatOffenders = append(report.RepeatOffenders, stash)
+		}
+		report.Offenders = append(report.Offenders, stats)
+	}
+
+	return report
+}
+
+// analyzeOffender computes the offence analytics of a single offender, offences must
+// be ordered by block
+func analyzeOffender(stash string, offences []Offence, sessionWindow int) OffenderStats {
+	stats := OffenderStats{
+		Stash:          stash,
+		TotalOffences:  len(offences),
+		OffencesByKind: map[string]int{},
+		FirstBlock:     offences[0].Block,
+		LastBlock:      offences[len(offences)-1].Block,
+	}
+
+	var sessions []int
+	for _, offence := range offences {
+		stats.OffencesByKind[offence.Kind]++
+		if offence.Session != nil {
+			sessions = append(sessions, *offence.Session)
+		}
+	}
+	sort.Ints(sessions)
+
+	// Sliding window over the sessions the offences happened in
+	start := 0
+	for end := range sessions {
+		for sessions[end]-sessions[start] >= sessionWindow {
+			start++
+		}
+		if count := end - start + 1; count > stats.MaxInWindow {
+			stats.MaxInWindow = count
+		}
+	}
+	stats.IsRepeatOffender = stats.MaxInWindow > 1
+
+	return stats
+}
+
+// GetOffencesByKind returns the number of offences of the validator by kind
+func (v *Validator) GetOffencesByKind() map[string]int {
+	counts := map[string]int{}
+	for _, event := range v.Events {
+		if !event.IsOffenceEvent() || !event.Involves(v.Stash) {
+			continue
+		}
+		kind, _ := event.GetStringField("kind")
+		counts[kind]++
+	}
+	return counts
+}
@@ -0,0 +1,73 @@
+package entities
+
+import "testing"
+
+func TestAnalyzeOffencesCountsKindsAndRepeatOffenders(t *testing.T) {
+	const good, bad = "5F3sa2TJc...Good", "5HGjWAeFD...Bad"
+
+	events := []Event{
+		newSession(100, 1),
+		newSession(200, 2),
+		newSession(800, 8),
+		offence(110, "babe_equivocation", bad),
+		// One event lists two offenders
+		offence(210, "offline", good, bad),
+		offence(810, "grandpa_equivocation", good),
+		*NewEvent(820, "staking.Slashed", map[string]interface{}{"staker": bad, "amount": 1}),
+		// Before the first session: counted, but outside any window
+		offence(50, "offline", good),
+	}
+
+	offences := ExtractOffences(events, NewSessionTimeline(events))
+
+	if len(offences) != 5 {
+		t.Fatalf("extracted %d offences, want 5: %+v", len(offences), offences)
+	}
+	if offences[0].Block != 50 || offences[0].Session != nil {
+		t.Errorf("first offence = %+v, want the sessionless one at block 50", offences[0])
+	}
+	if offences[1].Session == nil || *offences[1].Session != 1 {
+		t.Errorf("offence at block 110 is not attributed to session 1: %+v", offences[1])
+	}
+
+	report := AnalyzeOffences(offences, 6)
+
+	wantKinds := map[string]int{"offline": 3, "babe_equivocation": 1, "grandpa_equivocation": 1}
+	if len(report.OffencesByKind) != len(wantKinds) {
+		t.Errorf("offences by kind = %v, want %v", report.OffencesByKind, wantKinds)
+	}
+	for kind, count := range wantKinds {
+		if report.OffencesByKind[kind] != count {
+			t.Errorf("offences of kind %s = %d, want %d", kind, report.OffencesByKind[kind], count)
+		}
+	}
+
+	// bad offended in sessions 1 and 2, good in sessions 2 and 8 which are 6 apart
+	if len(report.RepeatOffenders) != 1 || report.RepeatOffenders[0] != bad {
+		t.Errorf("repeat offenders = %v, want [%s]", report.RepeatOffenders, bad)
+	}
+
+	stats := map[string]OffenderStats{}
+	for _, offender := range report.Offenders {
+		stats[offender.Stash] = offender
+	}
+	if got := stats[good]; got.TotalOffences != 3 || got.MaxInWindow != 1 || got.FirstBlock != 50 || got.LastBlock != 810 {
+		t.Errorf("stats of %s = %+v", good, got)
+	}
+	if got := stats[bad]; got.TotalOffences != 2 || got.MaxInWindow != 2 || got.OffencesByKind["babe_equivocation"] != 1 {
+		t.Errorf("stats of %s = %+v", bad, got)
+	}
+}
+
+func TestGetOffencesByKindCountsOnlyOwnOffences(t *testing.T) {
+	validator := NewValidator("5HGjWAeFD...Bad", ValidatorTypeBad, "")
+	validator.AddEvent(offence(100, "offline", "5HGjWAeFD...Bad"))
+	validator.AddEvent(offence(200, "offline", "5F3sa2TJc...Good"))
+	validator.AddEvent(offence(300, "babe_equivocation", "5F3sa2TJc...Good", "5HGjWAeFD...Bad"))
+
+	counts := validator.GetOffencesByKind()
+
+	if len(counts) != 2 || counts["offline"] != 1 || counts["babe_equivocation"] != 1 {
+		t.Errorf("GetOffencesByKind() = %v, want one offline and one babe_equivocation", counts)
+	}
+}
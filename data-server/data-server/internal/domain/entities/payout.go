@@ -0,0 +1,234 @@
+package entities
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DefaultPayoutLateThreshold is the number of blocks after an era has been paid
+// (roughly one day of 6 second blocks) after which a payout is considered late
+const DefaultPayoutLateThreshold = 14400
+
+// PayoutIssueKind represents the kind of problem found while reconciling payouts
+type PayoutIssueKind string
+
+const (
+	PayoutIssueUnrewarded PayoutIssueKind = "started_not_rewarded"
+	PayoutIssueMissing    PayoutIssueKind = "missing_payout"
+	PayoutIssueLate       PayoutIssueKind = "late_payout"
+	PayoutIssueSkippedEra PayoutIssueKind = "skipped_era"
+)
+
+// PayoutStatus represents the reconciled state of a single era payout
+type PayoutStatus string
+
+const (
+	PayoutStatusRewarded   PayoutStatus = "rewarded"
+	PayoutStatusUnrewarded PayoutStatus = "unrewarded"
+	PayoutStatusMissing    PayoutStatus = "missing"
+	PayoutStatusSkipped    PayoutStatus = "skipped"
+	PayoutStatusNotElected PayoutStatus = "not_elected"
+)
+
+// EraPayout represents the payout lifecycle of a validator for a single era
+type EraPayout struct {
+	Era                int          `json:"era"`
+	Status             PayoutStatus `json:"status"`
+	Elected            bool         `json:"elected"`
+	EraPaidBlock       int          `json:"era_paid_block,omitempty"`
+	PayoutStartedBlock int          `json:"payout_started_block,omitempty"`
+	RewardedBlock      int          `json:"rewarded_block,omitempty"`
+	Amount             int64        `json:"amount"`
+	DelayBlocks        int          `json:"delay_blocks"`
+	Late               bool         `json:"late"`
+}
+
+// PayoutIssue represents a single finding of the payout reconciliation
+type PayoutIssue struct {
+	Era         int             `json:"era"`
+	Kind        PayoutIssueKind `json:"kind"`
+	Block       int             `json:"block,omitempty"`
+	Description string          `json:"description"`
+}
+
+// PayoutSummary represents aggregated payout reconciliation counters
+type PayoutSummary struct {
+	ErasTracked     int   `json:"eras_tracked"`
+	RewardedEras    int   `json:"rewarded_eras"`
+	UnrewardedEras  int   `json:"unrewarded_eras"`
+	MissingPayouts  int   `json:"missing_payouts"`
+	LatePayouts     int   `json:"late_payouts"`
+	SkippedEras     int   `json:"skipped_eras"`
+	TotalPaidAmount int64 `json:"total_paid_amount"`
+}
+
+// PayoutReport represents the payout reconciliation of a validator
+type PayoutReport struct {
+	Stash         string        `json:"stash"`
+	LateThreshold int           `json:"late_threshold_blocks"`
+	Eras          []EraPayout   `json:"eras"`
+	Issues        []PayoutIssue `json:"issues"`
+	Summary       PayoutSummary `json:"summary"`
+}
+
+// HasIssues returns true if the reconciliation found any problem
+func (r *PayoutReport) HasIssues() bool {
+	return len(r.Issues) > 0
+}
+
+// ReconcilePayouts replays the staking events of the validator and checks, per era,
+// for payouts that were started but never rewarded, paid eras without a payout while
+// the validator was elected, payouts that are late relative to the era end and eras
+// that were skipped entirely
+func (v *Validator) ReconcilePayouts(lateThreshold int) *PayoutReport {
+	if lateThreshold <= 0 {
+		lateThreshold = DefaultPayoutLateThreshold
+	}
+
+	eras := map[int]*EraPayout{}
+	eraFor := func(era int) *EraPayout {
+		payout, exists := eras[era]
+		if !exists {
+			payout = &EraPayout{Era: era}
+			eras[era] = payout
+		}
+		return payout
+	}
+
+	// Rewarded events carry no era. payout_stakers of pallet-staking deposits PayoutStarted
+	// and then, within the same call, the Rewarded events of the validator and its paid
+	// nominators, so a Rewarded event belongs to the payout started last. A payout started
+	// for another validator ends the current one. Eras paid out in several pages start one
+	// payout per page, their rewards add up to the era and the first page sets the start
+	var current *EraPayout
+
+	// StakersElected carries no accounts, so the validator counts as elected for an era
+	// when it shows up in the active set before the era is paid: a heartbeat of its own
+	// or an offline report naming it
+	active := false
+
+	for _, event := range v.SortedEvents() {
+		switch event.Event {
+		case "imOnline.HeartbeatReceived":
+			if authority, ok := event.GetStringField("authority_id"); ok && authority == v.Stash {
+				active = true
+			}
+		case "imOnline.SomeOffline":
+			if containsString(event.GetStringListField("authority_ids"), v.Stash) {
+				active = true
+			}
+		case "staking.EraPaid":
+			era, ok := event.GetIntField("era_index")
+			if !ok {
+				continue
+			}
+			payout := eraFor(int(era))
+			payout.EraPaidBlock = event.Block
+			payout.Elected = payout.Elected || active
+			active = false
+		case "staking.PayoutStarted":
+			stash, _ := event.GetStringField("validator_stash")
+			era, ok := event.GetIntField("era_index")
+			if !ok {
+				continue
+			}
+			if stash != v.Stash {
+				current = nil
+				continue
+			}
+			current = eraFor(int(era))
+			if current.PayoutStartedBlock == 0 {
+				current.PayoutStartedBlock = event.Block
+			}
+			current.Elected = true
+		case "staking.Rewarded":
+			// Rewards of the nominators name their own stash and belong to their payout
+			if stash, ok := event.GetStash(); !ok || stash != v.Stash || current == nil {
+				continue
+			}
+			current.RewardedBlock = event.Block
+			if amount, ok := event.GetAmount(); ok {
+				current.Amount += amount
+			}
+		}
+	}
+
+	report := &PayoutReport{
+		Stash:         v.Stash,
+		LateThreshold: lateThreshold,
+		Eras:          []EraPayout{},
+		Issues:        []PayoutIssue{},
+	}
+	if len(eras) == 0 {
+		return report
+	}
+
+	indexes := make([]int, 0, len(eras))
+	for era := range eras {
+		indexes = append(indexes, era)
+	}
+	sort.Ints(indexes)
+
+	for era := indexes[0]; era <= indexes[len(indexes)-1]; era++ {
+		payout, exists := eras[era]
+		if !exists {
+			report.Eras = append(report.Eras, EraPayout{Era: era, Status: PayoutStatusSkipped})
+			report.addIssue(era, PayoutIssueSkippedEra, 0, fmt.Sprintf("era %d has no payout or era paid event", era))
+			continue
+		}
+
+		switch {
+		case payout.PayoutStartedBlock == 0:
+			if !payout.Elected {
+				// The validator was not in the active set, nothing was owed
+				payout.Status = PayoutStatusNotElected
+				report.Summary.ErasTracked++
+				report.Eras = append(report.Eras, *payout)
+				continue
+			}
+			payout.Status = PayoutStatusMissing
+			report.addIssue(era, PayoutIssueMissing, payout.EraPaidBlock, fmt.Sprintf("era %d was paid but no payout was started for the elected validator", era))
+		case payout.RewardedBlock == 0:
+			payout.Status = PayoutStatusUnrewarded
+			report.addIssue(era, PayoutIssueUnrewarded, payout.PayoutStartedBlock, fmt.Sprintf("payout for era %d was started but no reward followed", era))
+		default:
+			payout.Status = PayoutStatusRewarded
+			report.Summary.RewardedEras++
+			report.Summary.TotalPaidAmount += payout.Amount
+		}
+
+		if payout.EraPaidBlock > 0 && payout.PayoutStartedBlock > payout.EraPaidBlock {
+			payout.DelayBlocks = payout.PayoutStartedBlock - payout.EraPaidBlock
+			if payout.DelayBlocks > lateThreshold {
+				payout.Late = true
+				report.addIssue(era, PayoutIssueLate, payout.PayoutStartedBlock, fmt.Sprintf("payout for era %d started %d blocks after the era was paid", era, payout.DelayBlocks))
+			}
+		}
+
+		report.Summary.ErasTracked++
+		report.Eras = append(report.Eras, *payout)
+	}
+
+	return report
+}
+
+// addIssue records an issue and updates the matching summary counter
+func (r *PayoutReport) addIssue(era int, kind PayoutIssueKind, block int, description string) {
+	r.Issues = append(r.Issues, PayoutIssue{
+		Era:         era,
+		Kind:        kind,
+		Block:       block,
+		Description: description,
+	})
+
+	switch kind {
+	case PayoutIssueUnrewarded:
+		r.Summary.UnrewardedEras++
+	case PayoutIssueMissing:
+		r.Summary.MissingPayouts++
+	case PayoutIssueLate:
+		r.Summary.LatePayouts++
+	case PayoutIssueSkippedEra:
+		r.Summary.SkippedEras++
+	}
+}
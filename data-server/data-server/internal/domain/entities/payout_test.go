@@ -0,0 +1,230 @@
+package entities
+
+import "testing"
+
+const testPayoutStash = "5F3sa2TJc...Good"
+
+func payoutStarted(block, era int, stash string) Event {
+	return *NewEvent(block, "staking.PayoutStarted", map[string]interface{}{"era_index": era, "validator_stash": stash, "page": 0})
+}
+
+func rewarded(block int, stash string, amount int) Event {
+	return *NewEvent(block, "staking.Rewarded", map[string]interface{}{"stash": stash, "dest": "Stash", "amount": amount})
+}
+
+func heartbeat(block int, stash string) Event {
+	return *NewEvent(block, "imOnline.HeartbeatReceived", map[string]interface{}{"authority_id": stash})
+}
+
+func eraPaid(block, era int) Event {
+	return *NewEvent(block, "staking.EraPaid", map[string]interface{}{"era_index": era, "validator_payout": 1, "remainder": 0})
+}
+
+func TestReconcilePayoutsMatchesRewardsToTheCurrentPayout(t *testing.T) {
+	type wantEra struct {
+		era           int
+		status        PayoutStatus
+		rewardedBlock int
+		amount        int64
+	}
+
+	tests := []struct {
+		name   string
+		events []Event
+		eras   []wantEra
+		issues []PayoutIssueKind
+	}{
+		{
+			name: "each reward settles the payout started right before it",
+			events: []Event{
+				payoutStarted(100, 10, testPayoutStash),
+				rewarded(101, testPayoutStash, 5),
+				payoutStarted(102, 11, testPayoutStash),
+				rewarded(103, testPayoutStash, 7),
+			},
+			eras: []wantEra{
+				{era: 10, status: PayoutStatusRewarded, rewardedBlock: 101, amount: 5},
+				{era: 11, status: PayoutStatusRewarded, rewardedBlock: 103, amount: 7},
+			},
+		},
+		{
+			name: "rewards after a second started payout all settle the second one",
+			events: []Event{
+				payoutStarted(100, 10, testPayoutStash),
+				payoutStarted(101, 11, testPayoutStash),
+				rewarded(102, testPayoutStash, 5),
+				rewarded(103, testPayoutStash, 7),
+			},
+			eras: []wantEra{
+				{era: 10, status: PayoutStatusUnrewarded},
+				{era: 11, status: PayoutStatusRewarded, rewardedBlock: 103, amount: 12},
+			},
+			issues: []PayoutIssueKind{PayoutIssueUnrewarded},
+		},
+		{
+			name: "a payout started for another validator ends the current payout",
+			events: []Event{
+				payoutStarted(100, 10, testPayoutStash),
+				payoutStarted(101, 10, "5HGjWAeFD...Bad"),
+				rewarded(102, testPayoutStash, 5),
+			},
+			eras: []wantEra{
+				{era: 10, status: PayoutStatusUnrewarded},
+			},
+			issues: []PayoutIssueKind{PayoutIssueUnrewarded},
+		},
+		{
+			name: "a reward before any started payout is ignored",
+			events: []Event{
+				rewarded(99, testPayoutStash, 8),
+				payoutStarted(100, 10, testPayoutStash),
+				rewarded(101, testPayoutStash, 3),
+			},
+			eras: []wantEra{
+				{era: 10, status: PayoutStatusRewarded, rewardedBlock: 101, amount: 3},
+			},
+		},
+		{
+			name: "rewards of other stashes are not matched",
+			events: []Event{
+				payoutStarted(100, 10, testPayoutStash),
+				rewarded(101, "5HGjWAeFD...Bad", 9),
+				rewarded(102, testPayoutStash, 4),
+			},
+			eras: []wantEra{
+				{era: 10, status: PayoutStatusRewarded, rewardedBlock: 102, amount: 4},
+			},
+		},
+		{
+			name: "an era paid without a payout started for the validator is missing",
+			events: []Event{
+				heartbeat(98, testPayoutStash),
+				eraPaid(99, 10),
+				payoutStarted(100, 10, "5HGjWAeFD...Bad"),
+				rewarded(101, testPayoutStash, 6),
+			},
+			eras: []wantEra{
+				{era: 10, status: PayoutStatusMissing},
+			},
+			issues: []PayoutIssueKind{PayoutIssueMissing},
+		},
+		{
+			name: "an election without activity of the validator is not elected",
+			events: []Event{
+				*NewEvent(97, "staking.StakersElected", map[string]interface{}{}),
+				heartbeat(98, "5HGjWAeFD...Bad"),
+				eraPaid(99, 10),
+			},
+			eras: []wantEra{
+				{era: 10, status: PayoutStatusNotElected},
+			},
+		},
+		{
+			name: "activity counts only for the era it happened in",
+			events: []Event{
+				*NewEvent(95, "imOnline.SomeOffline", map[string]interface{}{"authority_ids": []interface{}{testPayoutStash}}),
+				eraPaid(96, 9),
+				payoutStarted(97, 9, testPayoutStash),
+				rewarded(97, testPayoutStash, 2),
+				eraPaid(99, 10),
+			},
+			eras: []wantEra{
+				{era: 9, status: PayoutStatusRewarded, rewardedBlock: 97, amount: 2},
+				{era: 10, status: PayoutStatusNotElected},
+			},
+		},
+		{
+			name: "payouts started out of era order are matched by start, not by era",
+			events: []Event{
+				payoutStarted(100, 12, testPayoutStash),
+				payoutStarted(101, 11, testPayoutStash),
+				rewarded(102, testPayoutStash, 5),
+			},
+			eras: []wantEra{
+				{era: 11, status: PayoutStatusRewarded, rewardedBlock: 102, amount: 5},
+				{era: 12, status: PayoutStatusUnrewarded},
+			},
+			issues: []PayoutIssueKind{PayoutIssueUnrewarded},
+		},
+		{
+			name: "eras between tracked eras are skipped",
+			events: []Event{
+				payoutStarted(100, 10, testPayoutStash),
+				rewarded(101, testPayoutStash, 5),
+				payoutStarted(102, 12, testPayoutStash),
+				rewarded(103, testPayoutStash, 6),
+			},
+			eras: []wantEra{
+				{era: 10, status: PayoutStatusRewarded, rewardedBlock: 101, amount: 5},
+				{era: 11, status: PayoutStatusSkipped},
+				{era: 12, status: PayoutStatusRewarded, rewardedBlock: 103, amount: 6},
+			},
+			issues: []PayoutIssueKind{PayoutIssueSkippedEra},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewValidator(testPayoutStash, ValidatorTypeGood, "")
+			for _, event := range tt.events {
+				validator.AddEvent(event)
+			}
+
+			report := validator.ReconcilePayouts(DefaultPayoutLateThreshold)
+
+			if len(report.Eras) != len(tt.eras) {
+				t.Fatalf("got %d eras %+v, want %d", len(report.Eras), report.Eras, len(tt.eras))
+			}
+			for i, want := range tt.eras {
+				got := report.Eras[i]
+				if got.Era != want.era || got.Status != want.status || got.RewardedBlock != want.rewardedBlock || got.Amount != want.amount {
+					t.Errorf("era %d = {era %d, %s, rewarded at %d, amount %d}, want {era %d, %s, rewarded at %d, amount %d}",
+						i, got.Era, got.Status, got.RewardedBlock, got.Amount, want.era, want.status, want.rewardedBlock, want.amount)
+				}
+			}
+
+			if len(report.Issues) != len(tt.issues) {
+				t.Fatalf("got issues %+v, want %v", report.Issues, tt.issues)
+			}
+			for i, kind := range tt.issues {
+				if report.Issues[i].Kind != kind {
+					t.Errorf("issue %d = %s, want %s", i, report.Issues[i].Kind, kind)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcilePayoutsAddsUpThePagesOfAnEra(t *testing.T) {
+	const nominator = "5Cnominator"
+
+	validator := NewValidator(testPayoutStash, ValidatorTypeGood, "")
+	for _, event := range []Event{
+		eraPaid(90, 10),
+		*NewEvent(100, "staking.PayoutStarted", map[string]interface{}{"era_index": 10, "validator_stash": testPayoutStash, "page": 0}),
+		rewarded(100, testPayoutStash, 5),
+		rewarded(100, nominator, 3),
+		rewarded(100, nominator, 2),
+		*NewEvent(110, "staking.PayoutStarted", map[string]interface{}{"era_index": 10, "validator_stash": testPayoutStash, "page": 1}),
+		rewarded(110, nominator, 4),
+		rewarded(110, testPayoutStash, 1),
+	} {
+		validator.AddEvent(event)
+	}
+
+	report := validator.ReconcilePayouts(DefaultPayoutLateThreshold)
+
+	if len(report.Eras) != 1 {
+		t.Fatalf("got eras %+v, want only era 10", report.Eras)
+	}
+	era := report.Eras[0]
+	if era.Status != PayoutStatusRewarded || era.Amount != 6 {
+		t.Errorf("era 10 is %s with amount %d, want rewarded with the validator's 5 + 1", era.Status, era.Amount)
+	}
+	if era.PayoutStartedBlock != 100 || era.DelayBlocks != 10 || era.RewardedBlock != 110 {
+		t.Errorf("era 10 started at %d with delay %d and rewarded at %d, want 100, 10 and 110", era.PayoutStartedBlock, era.DelayBlocks, era.RewardedBlock)
+	}
+	if report.HasIssues() {
+		t.Errorf("issues = %+v, want none", report.Issues)
+	}
+}
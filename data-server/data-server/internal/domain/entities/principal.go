@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"context"
+	"fmt"
+)
+
+// principalKey is the context key of the principal
+type principalKey struct{}
+
+// Principal represents the client a request is made on behalf of. Anonymous principals
+// have no key and get the scopes the server grants to unauthenticated requests
+type Principal struct {
+	KeyID     string        `json:"key_id,omitempty"`
+	Name      string        `json:"name"`
+	Scopes    []APIKeyScope `json:"scopes"`
+	RateLimit int           `json:"rate_limit"`
+}
+
+// AnonymousPrincipal returns the principal of unauthenticated requests, granted the
+// given scopes
+func AnonymousPrincipal(scopes ...APIKeyScope) *Principal {
+	return &Principal{
+		Name:   "anonymous",
+		Scopes: scopes,
+	}
+}
+
+// Anonymous returns true if the principal did not authenticate
+func (p *Principal) Anonymous() bool {
+	return p.KeyID == ""
+}
+
+// HasScope returns true if the principal is granted the scope, the admin scope grants
+// every scope
+func (p *Principal) HasScope(scope APIKeyScope) bool {
+	for _, granted := range p.Scopes {
+		if granted == scope || granted == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// WithPrincipal returns a copy of the context carrying the principal
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal carried by the context
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// RequireScope returns ErrUnauthenticated if the context carries no authenticated
+// principal granted the scope, or ErrForbidden if the principal authenticated but lacks
+// the scope
+func RequireScope(ctx context.Context, scope APIKeyScope) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if ok && principal.HasScope(scope) {
+		return nil
+	}
+	if !ok || principal.Anonymous() {
+		return fmt.Errorf("%w: the %s scope requires an API key", ErrUnauthenticated, scope)
+	}
+	return fmt.Errorf("%w: the %s scope is required", ErrForbidden, scope)
+}
@@ -0,0 +1,46 @@
+package entities
+
+import "sort"
+
+// SessionStart represents the block at which a session started
+type SessionStart struct {
+	Index int `json:"index"`
+	Block int `json:"block"`
+}
+
+// SessionTimeline represents the known session starts ordered by block
+type SessionTimeline []SessionStart
+
+// NewSessionTimeline builds a session timeline from session.NewSession events
+func NewSessionTimeline(events []Event) SessionTimeline {
+	seen := map[int]bool{}
+	timeline := SessionTimeline{}
+	for _, event := range events {
+		if event.Event != "session.NewSession" {
+			continue
+		}
+		index, ok := event.GetIntField("session_index")
+		if !ok || seen[int(index)] {
+			continue
+		}
+		seen[int(index)] = true
+		timeline = append(timeline, SessionStart{Index: int(index), Block: event.Block})
+	}
+
+	sort.Slice(timeline, func(i, j int) bool {
+		return timeline[i].Block < timeline[j].Block
+	})
+
+	return timeline
+}
+
+// SessionAt returns the index of the session the given block belongs to
+func (t SessionTimeline) SessionAt(block int) (int, bool) {
+	i := sort.Search(len(t), func(i int) bool {
+		return t[i].Block > block
+	})
+	if i == 0 {
+		return 0, false
+	}
+	return t[i-1].Index, true
+}
@@ -0,0 +1,58 @@
+package entities
+
+import "testing"
+
+func newSession(block, index int) Event {
+	return *NewEvent(block, "session.NewSession", map[string]interface{}{"session_index": index})
+}
+
+func TestSessionTimelineResolvesBlocksToSessions(t *testing.T) {
+	// Events arrive out of order and session 11 is reported twice
+	timeline := NewSessionTimeline([]Event{
+		newSession(300, 12),
+		newSession(100, 10),
+		*NewEvent(150, "imOnline.AllGood", map[string]interface{}{}),
+		newSession(200, 11),
+		newSession(250, 11),
+	})
+
+	if len(timeline) != 3 {
+		t.Fatalf("timeline = %+v, want 3 sessions", timeline)
+	}
+	for i := 1; i < len(timeline); i++ {
+		if timeline[i-1].Block >= timeline[i].Block {
+			t.Fatalf("timeline is not ordered by block: %+v", timeline)
+		}
+	}
+
+	// block -> session, -1 when the block is before the first known session
+	want := map[int]int{
+		99:   -1,
+		100:  10,
+		199:  10,
+		200:  11,
+		250:  11,
+		299:  11,
+		300:  12,
+		5000: 12,
+	}
+	for block, session := range want {
+		got, ok := timeline.SessionAt(block)
+		if session == -1 {
+			if ok {
+				t.Errorf("SessionAt(%d) = %d, want no session", block, got)
+			}
+			continue
+		}
+		if !ok || got != session {
+			t.Errorf("SessionAt(%d) = %d, %t, want %d", block, got, ok, session)
+		}
+	}
+}
+
+func TestSessionTimelineWithoutSessions(t *testing.T) {
+	timeline := NewSessionTimeline(nil)
+	if _, ok := timeline.SessionAt(100); ok {
+		t.Error("SessionAt() found a session in an empty timeline")
+	}
+}
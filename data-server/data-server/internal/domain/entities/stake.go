@@ -0,0 +1,180 @@
+package entities
+
+// StakeState represents the lifecycle state of a validator stash
+type StakeState string
+
+const (
+	StakeStateNone       StakeState = "none"
+	StakeStateBonded     StakeState = "bonded"
+	StakeStateValidating StakeState = "validating"
+	StakeStateChilled    StakeState = "chilled"
+	StakeStateUnbonding  StakeState = "unbonding"
+	StakeStateExited     StakeState = "exited"
+)
+
+// StakeEntry represents the stake balances of a stash right after a lifecycle event
+type StakeEntry struct {
+	Block     int        `json:"block"`
+	Event     string     `json:"event"`
+	Delta     int64      `json:"delta"`
+	Bonded    int64      `json:"bonded"`
+	Unbonding int64      `json:"unbonding"`
+	Withdrawn int64      `json:"withdrawn"`
+	Slashed   int64      `json:"slashed"`
+	State     StakeState `json:"state"`
+}
+
+// StakeTransition represents a change of the stash lifecycle state
+type StakeTransition struct {
+	Block int        `json:"block"`
+	Event string     `json:"event"`
+	From  StakeState `json:"from"`
+	To    StakeState `json:"to"`
+}
+
+// StakeLedger represents the bonded balance timeline and lifecycle of a stash
+type StakeLedger struct {
+	Stash       string            `json:"stash"`
+	State       StakeState        `json:"state"`
+	Bonded      int64             `json:"bonded"`
+	Unbonding   int64             `json:"unbonding"`
+	Withdrawn   int64             `json:"withdrawn"`
+	Slashed     int64             `json:"slashed"`
+	Timeline    []StakeEntry      `json:"timeline"`
+	Transitions []StakeTransition `json:"transitions"`
+}
+
+// HasLeftActiveSet returns true if the stash no longer backs an active validator
+func (l *StakeLedger) HasLeftActiveSet() bool {
+	switch l.State {
+	case StakeStateChilled, StakeStateUnbonding, StakeStateExited:
+		return true
+	}
+	return false
+}
+
+// StakeLedger replays the bonding, slashing and chilling events of the validator
+// into a bonded/unbonding/withdrawn balance timeline and a lifecycle state machine
+// (bonded -> validating -> chilled -> unbonding -> exited)
+func (v *Validator) StakeLedger() *StakeLedger {
+	ledger := &StakeLedger{
+		Stash:       v.Stash,
+		State:       StakeStateNone,
+		Timeline:    []StakeEntry{},
+		Transitions: []StakeTransition{},
+	}
+
+	for _, event := range v.SortedEvents() {
+		state := ledger.State
+		amount, _ := event.GetAmount()
+		var delta int64
+
+		switch event.Event {
+		case "staking.Bonded":
+			if !v.isOwnStash(event, "stash") {
+				continue
+			}
+			ledger.Bonded += amount
+			delta = amount
+			if state == StakeStateNone || state == StakeStateExited {
+				state = StakeStateBonded
+			}
+		case "staking.ValidatorPrefsSet":
+			if !v.isOwnStash(event, "stash") {
+				continue
+			}
+			if state == StakeStateBonded || state == StakeStateChilled {
+				state = StakeStateValidating
+			}
+		case "staking.StakersElected":
+			// Election results are network wide, they only matter once prefs are set
+			if state != StakeStateValidating {
+				continue
+			}
+		case "staking.Chilled":
+			if !v.isOwnStash(event, "stash") {
+				continue
+			}
+			if state == StakeStateBonded || state == StakeStateValidating {
+				state = StakeStateChilled
+			}
+		case "staking.Kicked":
+			if !v.isOwnStash(event, "stash") {
+				continue
+			}
+		case "staking.Slashed":
+			if !v.isOwnStash(event, "staker") {
+				continue
+			}
+			if amount > ledger.Bonded {
+				amount = ledger.Bonded
+			}
+			ledger.Bonded -= amount
+			ledger.Slashed += amount
+			delta = -amount
+		case "staking.Unbonded":
+			if !v.isOwnStash(event, "stash") {
+				continue
+			}
+			if amount > ledger.Bonded {
+				amount = ledger.Bonded
+			}
+			ledger.Bonded -= amount
+			ledger.Unbonding += amount
+			delta = -amount
+			if state != StakeStateNone && state != StakeStateExited {
+				state = StakeStateUnbonding
+			}
+		case "staking.Withdrawn":
+			if !v.isOwnStash(event, "stash") {
+				continue
+			}
+			if amount > ledger.Unbonding {
+				amount = ledger.Unbonding
+			}
+			ledger.Unbonding -= amount
+			ledger.Withdrawn += amount
+			if ledger.Bonded == 0 && ledger.Unbonding == 0 && state != StakeStateNone {
+				state = StakeStateExited
+			}
+		case "system.KilledAccount":
+			if !v.isOwnStash(event, "account") {
+				continue
+			}
+			if state != StakeStateNone {
+				state = StakeStateExited
+			}
+		default:
+			continue
+		}
+
+		if state != ledger.State {
+			ledger.Transitions = append(ledger.Transitions, StakeTransition{
+				Block: event.Block,
+				Event: event.Event,
+				From:  ledger.State,
+				To:    state,
+			})
+			ledger.State = state
+		}
+
+		ledger.Timeline = append(ledger.Timeline, StakeEntry{
+			Block:     event.Block,
+			Event:     event.Event,
+			Delta:     delta,
+			Bonded:    ledger.Bonded,
+			Unbonding: ledger.Unbonding,
+			Withdrawn: ledger.Withdrawn,
+			Slashed:   ledger.Slashed,
+			State:     ledger.State,
+		})
+	}
+
+	return ledger
+}
+
+// isOwnStash returns true if the given event field references the validator stash
+func (v *Validator) isOwnStash(event Event, key string) bool {
+	value, ok := event.GetStringField(key)
+	return ok && value == v.Stash
+}
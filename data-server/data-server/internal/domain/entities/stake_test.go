@@ -0,0 +1,85 @@
+package entities
+
+import "testing"
+
+const testStakeStash = "5F3sa2TJc...Good"
+
+func TestStakeLedgerFollowsTheStashLifecycle(t *testing.T) {
+	validator := NewValidator(testStakeStash, ValidatorTypeGood, "")
+
+	// Each step adds one event and checks the ledger replayed up to it
+	steps := []struct {
+		event     Event
+		state     StakeState
+		bonded    int64
+		unbonding int64
+		withdrawn int64
+		slashed   int64
+	}{
+		{*NewEvent(10, "staking.Bonded", map[string]interface{}{"stash": testStakeStash, "amount": 1000}), StakeStateBonded, 1000, 0, 0, 0},
+		{*NewEvent(11, "staking.ValidatorPrefsSet", map[string]interface{}{"stash": testStakeStash}), StakeStateValidating, 1000, 0, 0, 0},
+		{*NewEvent(12, "staking.StakersElected", map[string]interface{}{}), StakeStateValidating, 1000, 0, 0, 0},
+		{*NewEvent(13, "staking.Chilled", map[string]interface{}{"stash": testStakeStash}), StakeStateChilled, 1000, 0, 0, 0},
+		{*NewEvent(14, "staking.ValidatorPrefsSet", map[string]interface{}{"stash": testStakeStash}), StakeStateValidating, 1000, 0, 0, 0},
+		{*NewEvent(15, "staking.Slashed", map[string]interface{}{"staker": testStakeStash, "amount": 100}), StakeStateValidating, 900, 0, 0, 100},
+		{*NewEvent(16, "staking.Unbonded", map[string]interface{}{"stash": testStakeStash, "amount": 400}), StakeStateUnbonding, 500, 400, 0, 100},
+		{*NewEvent(17, "staking.Withdrawn", map[string]interface{}{"stash": testStakeStash, "amount": 400}), StakeStateUnbonding, 500, 0, 400, 100},
+		{*NewEvent(18, "staking.Unbonded", map[string]interface{}{"stash": testStakeStash, "amount": 800}), StakeStateUnbonding, 0, 500, 400, 100},
+		{*NewEvent(19, "staking.Withdrawn", map[string]interface{}{"stash": testStakeStash, "amount": 500}), StakeStateExited, 0, 0, 900, 100},
+		{*NewEvent(20, "staking.Bonded", map[string]interface{}{"stash": testStakeStash, "amount": 300}), StakeStateBonded, 300, 0, 900, 100},
+	}
+
+	for _, step := range steps {
+		validator.AddEvent(step.event)
+		ledger := validator.StakeLedger()
+
+		if ledger.State != step.state {
+			t.Errorf("after %s at %d: state = %s, want %s", step.event.Event, step.event.Block, ledger.State, step.state)
+		}
+		if ledger.Bonded != step.bonded || ledger.Unbonding != step.unbonding || ledger.Withdrawn != step.withdrawn || ledger.Slashed != step.slashed {
+			t.Errorf("after %s at %d: balances = %d/%d/%d/%d, want %d/%d/%d/%d", step.event.Event, step.event.Block,
+				ledger.Bonded, ledger.Unbonding, ledger.Withdrawn, ledger.Slashed,
+				step.bonded, step.unbonding, step.withdrawn, step.slashed)
+		}
+	}
+
+	ledger := validator.StakeLedger()
+	if len(ledger.Timeline) != len(steps) {
+		t.Errorf("timeline has %d entries, want %d", len(ledger.Timeline), len(steps))
+	}
+
+	var path []StakeState
+	for _, transition := range ledger.Transitions {
+		path = append(path, transition.To)
+	}
+	want := []StakeState{StakeStateBonded, StakeStateValidating, StakeStateChilled, StakeStateValidating, StakeStateUnbonding, StakeStateExited, StakeStateBonded}
+	if len(path) != len(want) {
+		t.Fatalf("transitions = %v, want %v", path, want)
+	}
+	for i := range want {
+		if path[i] != want[i] {
+			t.Fatalf("transitions = %v, want %v", path, want)
+		}
+	}
+}
+
+func TestStakeLedgerIgnoresEventsOfOtherStashes(t *testing.T) {
+	validator := NewValidator(testStakeStash, ValidatorTypeGood, "")
+	validator.AddEvent(*NewEvent(10, "staking.Bonded", map[string]interface{}{"stash": testStakeStash, "amount": 1000}))
+	validator.AddEvent(*NewEvent(11, "staking.Bonded", map[string]interface{}{"stash": "5HGjWAeFD...Bad", "amount": 50}))
+	validator.AddEvent(*NewEvent(12, "staking.Slashed", map[string]interface{}{"staker": "5HGjWAeFD...Bad", "amount": 20}))
+	validator.AddEvent(*NewEvent(13, "staking.Chilled", map[string]interface{}{"stash": "5HGjWAeFD...Bad"}))
+	validator.AddEvent(*NewEvent(14, "staking.StakersElected", map[string]interface{}{}))
+
+	ledger := validator.StakeLedger()
+
+	if ledger.State != StakeStateBonded || ledger.Bonded != 1000 || ledger.Slashed != 0 {
+		t.Errorf("ledger = %s with %d bonded and %d slashed, want bonded with 1000 bonded and 0 slashed", ledger.State, ledger.Bonded, ledger.Slashed)
+	}
+	if len(ledger.Timeline) != 1 {
+		t.Errorf("timeline = %+v, want only the own bond", ledger.Timeline)
+	}
+	if ledger.HasLeftActiveSet() {
+		t.Error("HasLeftActiveSet() = true for a bonded stash")
+	}
+}
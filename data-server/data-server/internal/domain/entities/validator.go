@@ -1,7 +1,10 @@
 package entities
 
 import (
+	"sort"
 	"time"
+
+	"data-server/internal/domain/valueobjects"
 )
 
 // ValidatorType represents the type of validator
@@ -90,12 +93,25 @@ func (v *Validator) GetTotalRewards() int64 {
 	var totalRewards int64
 	for _, event := range v.Events {
 		if event.Event == "staking.Rewarded" {
-			if data, ok := event.Data.(map[string]interface{}); ok {
-				if amount, ok := data["amount"].(int64); ok {
-					totalRewards += amount
-				}
+			if amount, ok := event.GetAmount(); ok {
+				totalRewards += amount
 			}
 		}
 	}
 	return totalRewards
-} 
\ No newline at end of file
+}
+
+// SortedEvents returns a copy of the validator's events ordered by block
+func (v *Validator) SortedEvents() []Event {
+	events := make([]Event, len(v.Events))
+	copy(events, v.Events)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Block < events[j].Block
+	})
+	return events
+}
+
+// Position returns the stable position of the validator, ordered by type
+func (v *Validator) Position() valueobjects.CursorKey {
+	return valueobjects.CursorKey{ID: string(v.Type)}
+}
@@ -0,0 +1,15 @@
+package entities
+
+import "data-server/internal/domain/valueobjects"
+
+// ValidatorEvent represents an event together with the stash of the validator it
+// belongs to, as delivered to live event subscribers
+type ValidatorEvent struct {
+	Stash string `json:"stash"`
+	Event
+}
+
+// ID returns the stream identifier of the event, written as block-index
+func (e ValidatorEvent) ID() string {
+	return valueobjects.FormatEventID(e.Position())
+}
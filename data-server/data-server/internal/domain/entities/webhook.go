@@ -0,0 +1,200 @@
+package entities
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"data-server/internal/domain/domainerr"
+	"data-server/internal/domain/valueobjects"
+)
+
+const (
+	// WebhookMaxAttempts is the number of delivery attempts before a delivery is abandoned
+	WebhookMaxAttempts = 8
+
+	// WebhookRetryBaseDelay is the delay before the first retry, doubled on every retry
+	WebhookRetryBaseDelay = 2 * time.Second
+
+	// WebhookRetryMaxDelay is the maximum delay between two delivery attempts
+	WebhookRetryMaxDelay = 10 * time.Minute
+
+	// WebhookDisableThreshold is the number of consecutive failed attempts after which a
+	// subscription is disabled
+	WebhookDisableThreshold = 10
+
+	// WebhookPingEvent is the event type of test deliveries
+	WebhookPingEvent = "webhook.ping"
+)
+
+var (
+	// ErrWebhookNotFound is returned when a webhook subscription does not exist
+	ErrWebhookNotFound = domainerr.New(domainerr.NotFound, "webhook_not_found", "webhook not found")
+
+	// ErrInvalidWebhook is returned when a webhook subscription is invalid
+	ErrInvalidWebhook = domainerr.New(domainerr.InvalidInput, "invalid_webhook", "invalid webhook")
+)
+
+// WebhookFilter represents the events a webhook subscription is notified about. Every
+// non empty criterion must match; values within a criterion are alternatives
+type WebhookFilter struct {
+	Types      []string `json:"types,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+	Stashes    []string `json:"stashes,omitempty"`
+}
+
+// Matches returns true if the event matches the filter
+func (f WebhookFilter) Matches(event ValidatorEvent) bool {
+	query := EventQuery{
+		Types:      f.Types,
+		Categories: f.Categories,
+		Stashes:    f.Stashes,
+	}
+	return query.matchesCriteria(event.Stash, event.Event)
+}
+
+// WebhookSubscription represents an endpoint notified of matching events
+type WebhookSubscription struct {
+	ID                  string        `json:"id"`
+	URL                 string        `json:"url"`
+	Secret              string        `json:"secret,omitempty"`
+	Description         string        `json:"description,omitempty"`
+	Filter              WebhookFilter `json:"filter"`
+	Enabled             bool          `json:"enabled"`
+	DisabledReason      string        `json:"disabled_reason,omitempty"`
+	ConsecutiveFailures int           `json:"consecutive_failures"`
+	LastDeliveryAt      *time.Time    `json:"last_delivery_at,omitempty"`
+	CreatedAt           time.Time     `json:"created_at"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+}
+
+// Validate returns an error if the subscription cannot be delivered to
+func (s *WebhookSubscription) Validate() error {
+	endpoint, err := url.Parse(s.URL)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhook)
+	}
+	if len(s.Secret) < 16 {
+		return fmt.Errorf("%w: secret must be at least 16 characters", ErrInvalidWebhook)
+	}
+	return nil
+}
+
+// RecordResult updates the failure counter after a delivery attempt, disabling the
+// subscription once it failed WebhookDisableThreshold times in a row
+func (s *WebhookSubscription) RecordResult(succeeded bool, at time.Time) {
+	s.LastDeliveryAt = &at
+	if succeeded {
+		s.ConsecutiveFailures = 0
+		return
+	}
+
+	s.ConsecutiveFailures++
+	if s.Enabled && s.ConsecutiveFailures >= WebhookDisableThreshold {
+		s.Enabled = false
+		s.DisabledReason = fmt.Sprintf("disabled after %d consecutive failed deliveries", s.ConsecutiveFailures)
+	}
+}
+
+// Position returns the stable position of the subscription, ordered by identifier
+func (s WebhookSubscription) Position() valueobjects.CursorKey {
+	return valueobjects.CursorKey{ID: s.ID}
+}
+
+// WebhookDeliveryStatus represents the state of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryCanceled  WebhookDeliveryStatus = "canceled"
+)
+
+// WebhookAttempt represents a single attempt to deliver a webhook
+type WebhookAttempt struct {
+	Attempt    int       `json:"attempt"`
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+// WebhookDelivery represents an event queued in the outbox for a subscription, with the
+// log of its delivery attempts
+type WebhookDelivery struct {
+	ID             string                `json:"id"`
+	Sequence       int                   `json:"sequence"`
+	SubscriptionID string                `json:"subscription_id"`
+	EventID        string                `json:"event_id"`
+	EventType      string                `json:"event_type"`
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       []WebhookAttempt      `json:"attempts"`
+	NextAttemptAt  *time.Time            `json:"next_attempt_at,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	CompletedAt    *time.Time            `json:"completed_at,omitempty"`
+}
+
+// RecordAttempt appends an attempt to the delivery log. Failed attempts are retried with
+// exponential backoff until WebhookMaxAttempts is reached
+func (d *WebhookDelivery) RecordAttempt(attempt WebhookAttempt) {
+	attempt.Attempt = len(d.Attempts) + 1
+	d.Attempts = append(d.Attempts, attempt)
+
+	if attempt.Error == "" {
+		d.complete(WebhookDeliverySucceeded, attempt.At)
+		return
+	}
+	if attempt.Attempt >= WebhookMaxAttempts {
+		d.complete(WebhookDeliveryFailed, attempt.At)
+		return
+	}
+
+	next := attempt.At.Add(WebhookRetryDelay(attempt.Attempt))
+	d.NextAttemptAt = &next
+}
+
+// Cancel abandons a pending delivery
+func (d *WebhookDelivery) Cancel(at time.Time) {
+	d.complete(WebhookDeliveryCanceled, at)
+}
+
+// complete marks the delivery as finished with the given status
+func (d *WebhookDelivery) complete(status WebhookDeliveryStatus, at time.Time) {
+	d.Status = status
+	d.NextAttemptAt = nil
+	d.CompletedAt = &at
+}
+
+// Position returns the stable position of the delivery, ordered by enqueue sequence
+func (d WebhookDelivery) Position() valueobjects.CursorKey {
+	return valueobjects.CursorKey{Index: d.Sequence, ID: d.ID}
+}
+
+// WebhookRetryDelay returns the delay before retrying a delivery after the given attempt
+func WebhookRetryDelay(attempt int) time.Duration {
+	delay := WebhookRetryBaseDelay
+	for i := 1; i < attempt && delay < WebhookRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > WebhookRetryMaxDelay {
+		delay = WebhookRetryMaxDelay
+	}
+	return delay
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 signature of a webhook payload,
+// computed over the timestamp and the body joined by a dot
+func SignWebhookPayload(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,194 @@
+package expression
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"data-server/internal/domain/domainerr"
+	"data-server/internal/domain/entities"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/interpreter"
+)
+
+const (
+	// MaxExpressionLength is the maximum length of a filter expression in characters
+	MaxExpressionLength = 1024
+
+	// DefaultCostLimit is the maximum evaluation cost of a filter expression per event
+	DefaultCostLimit = 10000
+
+	// interruptCheckFrequency is the number of comprehension iterations between checks
+	// of the cancellation of an evaluation
+	interruptCheckFrequency = 100
+)
+
+var (
+	// ErrInvalidFilter is returned when a filter expression cannot be compiled
+	ErrInvalidFilter = domainerr.New(domainerr.InvalidInput, "invalid_filter", "invalid filter expression")
+
+	// ErrCostLimitExceeded is returned when evaluating a filter exceeds its cost limit
+	ErrCostLimitExceeded = domainerr.New(domainerr.InvalidInput, "filter_cost_limit_exceeded", "filter expression exceeded its cost limit")
+)
+
+// CompileError is returned when a filter expression cannot be compiled
+type CompileError struct {
+	Expression string
+	Reason     string
+}
+
+// Error implements the error interface
+func (e *CompileError) Error() string {
+	return fmt.Sprintf("invalid filter expression: %s", e.Reason)
+}
+
+// Unwrap returns ErrInvalidFilter, the domain error of every compile error
+func (e *CompileError) Unwrap() error {
+	return ErrInvalidFilter
+}
+
+// Filter is a compiled, sandboxed CEL expression evaluated against events. The
+// expression sees the variables block, index, event, category, timestamp and data,
+// e.g. `event == "staking.Rewarded" && data.amount > 15e9`
+type Filter struct {
+	source  string
+	program cel.Program
+}
+
+// environment declares the variables available to filter expressions
+var environment = mustEnvironment()
+
+// mustEnvironment creates the CEL environment shared by all filters
+func mustEnvironment() *cel.Env {
+	env, err := cel.NewEnv(
+		cel.Variable("block", cel.IntType),
+		cel.Variable("index", cel.IntType),
+		cel.Variable("event", cel.StringType),
+		cel.Variable("category", cel.StringType),
+		cel.Variable("timestamp", cel.TimestampType),
+		cel.Variable("data", cel.MapType(cel.StringType, cel.DynType)),
+		cel.CrossTypeNumericComparisons(true),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return env
+}
+
+// Compile parses and type checks a filter expression. The expression must evaluate to
+// a boolean and is limited to DefaultCostLimit per evaluated event
+func Compile(source string) (*Filter, error) {
+	return CompileWithCostLimit(source, DefaultCostLimit)
+}
+
+// CompileWithCostLimit compiles a filter expression with a custom cost limit
+func CompileWithCostLimit(source string, costLimit uint64) (*Filter, error) {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return nil, &CompileError{Expression: source, Reason: "expression is empty"}
+	}
+	if len(source) > MaxExpressionLength {
+		return nil, &CompileError{Expression: source, Reason: fmt.Sprintf("expression is longer than %d characters", MaxExpressionLength)}
+	}
+
+	ast, issues := environment.Compile(source)
+	if issues != nil && issues.Err() != nil {
+		return nil, &CompileError{Expression: source, Reason: issues.Err().Error()}
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, &CompileError{Expression: source, Reason: fmt.Sprintf("expression must evaluate to bool, not %s", ast.OutputType())}
+	}
+
+	program, err := environment.Program(ast,
+		cel.CostLimit(costLimit),
+		cel.InterruptCheckFrequency(interruptCheckFrequency),
+	)
+	if err != nil {
+		return nil, &CompileError{Expression: source, Reason: err.Error()}
+	}
+
+	return &Filter{source: source, program: program}, nil
+}
+
+// String returns the source of the filter expression
+func (f *Filter) String() string {
+	return f.source
+}
+
+// Matches evaluates the filter against an event. Missing payload fields do not match.
+// Every evaluation has the full cost limit of the filter, and stops when the context is
+// canceled
+func (f *Filter) Matches(ctx context.Context, e entities.Event) (bool, error) {
+	result, _, err := f.program.ContextEval(ctx, map[string]interface{}{
+		"block":     int64(e.Block),
+		"index":     int64(e.Index),
+		"event":     e.Event,
+		"category":  e.GetEventCategory(),
+		"timestamp": e.Timestamp,
+		"data":      payload(e.Data),
+	})
+
+	var cancelled interpreter.EvalCancelledError
+	if errors.As(err, &cancelled) && cancelled.Cause == interpreter.CostLimitExceeded {
+		return false, ErrCostLimitExceeded
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return false, ctxErr
+	}
+
+	if err != nil {
+		// Evaluation errors such as missing keys or mismatched types mean no match
+		return false, nil
+	}
+
+	matched, ok := result.(types.Bool)
+	return ok && bool(matched), nil
+}
+
+// payload returns the event data as a map, events without a data map get an empty one
+func payload(data interface{}) interface{} {
+	if normalized, ok := normalize(data).(map[string]interface{}); ok {
+		return normalized
+	}
+	return map[string]interface{}{}
+}
+
+// normalize converts event payloads into values the CEL type adapter understands
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint32:
+		return uint64(v)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = normalize(item)
+		}
+		return result
+	case []map[string]interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = normalize(item)
+		}
+		return result
+	case []string:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = item
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = normalize(item)
+		}
+		return result
+	}
+	return value
+}
@@ -0,0 +1,174 @@
+package expression
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"data-server/internal/domain/entities"
+)
+
+// testEvent returns a reward of 20 DOT at block 114018
+func testEvent() entities.Event {
+	event := entities.NewEvent(114018, "staking.Rewarded", map[string]interface{}{
+		"stash":  "5F3sa2TJc...Good",
+		"amount": 20000000000,
+		"vote":   map[string]interface{}{"Standard": map[string]interface{}{"vote": "nay"}},
+		"tags":   []string{"era", "payout"},
+	})
+	event.Index = 2
+	event.Timestamp = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	return *event
+}
+
+func TestFilterMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		want       bool
+	}{
+		{name: "event type", expression: `event == "staking.Rewarded"`, want: true},
+		{name: "other event type", expression: `event == "staking.Slashed"`, want: false},
+		{name: "category", expression: `category == "staking"`, want: true},
+		{name: "block and index", expression: `block == 114018 && index == 2`, want: true},
+		{name: "integer compared to a double", expression: `data.amount > 15e9`, want: true},
+		{name: "integer below the threshold", expression: `data.amount > 25e9`, want: false},
+		{name: "nested field", expression: `has(data.vote) && data.vote.Standard.vote == "nay"`, want: true},
+		{name: "list membership", expression: `"payout" in data.tags`, want: true},
+		{name: "timestamp", expression: `timestamp > timestamp("2024-01-01T00:00:00Z")`, want: true},
+		{name: "missing field does not match", expression: `data.missing == 1`, want: false},
+		{name: "mismatched type does not match", expression: `data.stash > 1`, want: false},
+		{name: "dynamic result", expression: `data.matched`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := Compile(tt.expression)
+			if err != nil {
+				t.Fatalf("Compile(%s): %v", tt.expression, err)
+			}
+			got, err := filter.Matches(context.Background(), testEvent())
+			if err != nil {
+				t.Fatalf("Matches: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Matches(%s) = %t, want %t", tt.expression, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileRejectsInvalidExpressions(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{name: "empty", expression: "  "},
+		{name: "syntax error", expression: `event ==`},
+		{name: "unknown variable", expression: `stash == "5F3sa2TJc...Good"`},
+		{name: "not a boolean", expression: `block + 1`},
+		{name: "too long", expression: `event == "` + string(make([]byte, MaxExpressionLength)) + `"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.expression)
+			if !errors.Is(err, ErrInvalidFilter) {
+				t.Errorf("Compile error = %v, want ErrInvalidFilter", err)
+			}
+			var compileErr *CompileError
+			if !errors.As(err, &compileErr) || compileErr.Reason == "" {
+				t.Errorf("Compile error = %v, want a CompileError with a reason", err)
+			}
+		})
+	}
+}
+
+func TestFilterCostLimits(t *testing.T) {
+	// Every comprehension over the tags costs a few units per element
+	const expression = `data.tags.all(t, t.size() > 0) && data.tags.exists(t, t == "payout")`
+
+	tests := []struct {
+		name      string
+		costLimit uint64
+		events    int
+		wantErr   error
+	}{
+		{name: "within the limit", costLimit: DefaultCostLimit, events: 1},
+		{name: "event above its cost limit", costLimit: 1, events: 1, wantErr: ErrCostLimitExceeded},
+		// Each evaluation costs less than 100, together they cost far more
+		{name: "every event has its own limit", costLimit: 100, events: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := CompileWithCostLimit(expression, tt.costLimit)
+			if err != nil {
+				t.Fatalf("CompileWithCostLimit: %v", err)
+			}
+
+			ctx := context.Background()
+			var matchErr error
+			for i := 0; i < tt.events && matchErr == nil; i++ {
+				var matched bool
+				matched, matchErr = filter.Matches(ctx, testEvent())
+				if matchErr == nil && !matched {
+					t.Fatalf("event %d did not match", i)
+				}
+			}
+
+			if tt.wantErr == nil && matchErr != nil {
+				t.Errorf("Matches: %v", matchErr)
+			}
+			if tt.wantErr != nil && !errors.Is(matchErr, tt.wantErr) {
+				t.Errorf("Matches error = %v, want %v", matchErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFilterMatchesCanceledContext(t *testing.T) {
+	filter, err := Compile(`event == "staking.Rewarded"`)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := filter.Matches(ctx, testEvent()); !errors.Is(err, context.Canceled) {
+		t.Errorf("Matches error = %v, want context.Canceled", err)
+	}
+}
+
+func TestEventQueryMatchesFilter(t *testing.T) {
+	filter, err := Compile(`data.amount > 15e9`)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query entities.EventQuery
+		stash string
+		want  bool
+	}{
+		{name: "filter only", query: entities.EventQuery{Filter: filter}, stash: "5F3sa2TJc...Good", want: true},
+		{name: "filter and type", query: entities.EventQuery{Types: []string{"staking.Rewarded"}, Filter: filter}, stash: "5F3sa2TJc...Good", want: true},
+		{name: "criteria fail before the filter", query: entities.EventQuery{Types: []string{"staking.Slashed"}, Filter: filter}, stash: "5F3sa2TJc...Good", want: false},
+		{name: "other stash", query: entities.EventQuery{Stashes: []string{"5HGjWAeFD...Bad"}, Filter: filter}, stash: "5F3sa2TJc...Good", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.Matches(context.Background(), tt.stash, testEvent())
+			if err != nil {
+				t.Fatalf("Matches: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Matches = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
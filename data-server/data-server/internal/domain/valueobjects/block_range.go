@@ -1,6 +1,13 @@
 package valueobjects
 
-import "errors"
+import (
+	"fmt"
+
+	"data-server/internal/domain/domainerr"
+)
+
+// ErrInvalidBlockRange is returned when a block range is negative or inverted
+var ErrInvalidBlockRange = domainerr.New(domainerr.InvalidRange, "invalid_block_range", "invalid block range")
 
 // BlockRange represents a range of blocks
 type BlockRange struct {
@@ -8,16 +15,17 @@ type BlockRange struct {
 	EndBlock   int
 }
 
-// NewBlockRange creates a new block range
+// NewBlockRange creates a new block range, returning ErrInvalidBlockRange if the start
+// or end block is negative or the start block is after the end block
 func NewBlockRange(startBlock, endBlock int) (*BlockRange, error) {
 	if startBlock < 0 {
-		return nil, errors.New("start block cannot be negative")
+		return nil, fmt.Errorf("%w: start block cannot be negative", ErrInvalidBlockRange)
 	}
 	if endBlock < 0 {
-		return nil, errors.New("end block cannot be negative")
+		return nil, fmt.Errorf("%w: end block cannot be negative", ErrInvalidBlockRange)
 	}
 	if startBlock > endBlock {
-		return nil, errors.New("start block cannot be greater than end block")
+		return nil, fmt.Errorf("%w: start block cannot be greater than end block", ErrInvalidBlockRange)
 	}
 
 	return &BlockRange{
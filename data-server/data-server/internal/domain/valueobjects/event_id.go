@@ -0,0 +1,39 @@
+package valueobjects
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"data-server/internal/domain/domainerr"
+)
+
+// ErrInvalidEventID is returned when a stream event identifier is malformed
+var ErrInvalidEventID = domainerr.New(domainerr.InvalidInput, "invalid_event_id", "invalid event id")
+
+// FormatEventID formats the position of an event as a stream event identifier,
+// written as block-index, e.g. "112076-0"
+func FormatEventID(key CursorKey) string {
+	return fmt.Sprintf("%d-%d", key.Block, key.Index)
+}
+
+// ParseEventID parses a stream event identifier written as block-index. A bare block
+// number identifies the last event of that block, so streams resume at the next block
+func ParseEventID(id string) (CursorKey, error) {
+	blockStr, indexStr, hasIndex := strings.Cut(strings.TrimSpace(id), "-")
+
+	block, err := strconv.Atoi(blockStr)
+	if err != nil || block < 0 {
+		return CursorKey{}, fmt.Errorf("%w: event id must be written as block-index or block", ErrInvalidEventID)
+	}
+	if !hasIndex {
+		return CursorKey{Block: block, Index: math.MaxInt}, nil
+	}
+
+	index, err := strconv.Atoi(indexStr)
+	if err != nil || index < 0 {
+		return CursorKey{}, fmt.Errorf("%w: event id must be written as block-index or block", ErrInvalidEventID)
+	}
+	return CursorKey{Block: block, Index: index}, nil
+}
@@ -0,0 +1,208 @@
+package valueobjects
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"data-server/internal/domain/domainerr"
+)
+
+// ErrInvalidPagination is returned when the limit, cursor or order of a page request is invalid
+var ErrInvalidPagination = domainerr.New(domainerr.InvalidInput, "invalid_pagination", "invalid pagination")
+
+const (
+	// DefaultPageLimit is the number of items returned when no limit is requested
+	DefaultPageLimit = 100
+
+	// MaxPageLimit is the maximum number of items returned in a single page
+	MaxPageLimit = 1000
+)
+
+// SortDirection represents the direction of a stable ordering
+type SortDirection string
+
+const (
+	SortAscending  SortDirection = "asc"
+	SortDescending SortDirection = "desc"
+)
+
+// CursorKey identifies the position of an item in a stable ordering. Events are
+// ordered by block and event index, other items by their identifier
+type CursorKey struct {
+	Block int    `json:"b,omitempty"`
+	Index int    `json:"i,omitempty"`
+	ID    string `json:"k,omitempty"`
+}
+
+// Compare returns -1, 0 or 1 depending on whether the key sorts before, equal to or
+// after the other key
+func (k CursorKey) Compare(other CursorKey) int {
+	switch {
+	case k.Block != other.Block:
+		return compareInts(k.Block, other.Block)
+	case k.Index != other.Index:
+		return compareInts(k.Index, other.Index)
+	default:
+		return strings.Compare(k.ID, other.ID)
+	}
+}
+
+// EncodeCursor encodes a cursor key into an opaque cursor string
+func EncodeCursor(key CursorKey) string {
+	data, _ := json.Marshal(key)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decodes an opaque cursor string into a cursor key
+func DecodeCursor(cursor string) (CursorKey, error) {
+	var key CursorKey
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return key, fmt.Errorf("%w: malformed cursor", ErrInvalidPagination)
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return key, fmt.Errorf("%w: malformed cursor", ErrInvalidPagination)
+	}
+	return key, nil
+}
+
+// PageRequest represents a request for a page of a list
+type PageRequest struct {
+	Limit     int
+	Cursor    string
+	Direction SortDirection
+}
+
+// NewPageRequest creates a new page request, applying the default limit and direction
+func NewPageRequest(limit int, cursor string, direction SortDirection) (PageRequest, error) {
+	if limit < 0 {
+		return PageRequest{}, fmt.Errorf("%w: limit cannot be negative", ErrInvalidPagination)
+	}
+	if limit > MaxPageLimit {
+		return PageRequest{}, fmt.Errorf("%w: limit cannot be greater than %d", ErrInvalidPagination, MaxPageLimit)
+	}
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+
+	switch direction {
+	case "":
+		direction = SortAscending
+	case SortAscending, SortDescending:
+	default:
+		return PageRequest{}, fmt.Errorf("%w: sort direction must be asc or desc", ErrInvalidPagination)
+	}
+
+	if cursor != "" {
+		if _, err := DecodeCursor(cursor); err != nil {
+			return PageRequest{}, err
+		}
+	}
+
+	return PageRequest{
+		Limit:     limit,
+		Cursor:    cursor,
+		Direction: direction,
+	}, nil
+}
+
+// DefaultPageRequest returns the page request used when a client does not ask for one
+func DefaultPageRequest() PageRequest {
+	return PageRequest{
+		Limit:     DefaultPageLimit,
+		Direction: SortAscending,
+	}
+}
+
+// PageInfo represents the pagination metadata of a returned page
+type PageInfo struct {
+	Limit      int           `json:"limit"`
+	Direction  SortDirection `json:"direction"`
+	Count      int           `json:"count"`
+	Total      int           `json:"total"`
+	HasMore    bool          `json:"has_more"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+// Paginate orders items by their cursor key in the requested direction and returns the
+// page following the requested cursor
+func Paginate[T any](items []T, keyOf func(T) CursorKey, page PageRequest) ([]T, PageInfo, error) {
+	sorted := make([]T, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return keyOf(sorted[i]).Compare(keyOf(sorted[j])) < 0
+	})
+
+	return PaginateOrdered(sorted, keyOf, page)
+}
+
+// PaginateOrdered returns the page following the requested cursor of items already
+// ordered by their cursor key in ascending order, walking them backwards when the
+// descending direction is requested
+func PaginateOrdered[T any](items []T, keyOf func(T) CursorKey, page PageRequest) ([]T, PageInfo, error) {
+	if page.Limit <= 0 {
+		page.Limit = DefaultPageLimit
+	}
+	if page.Direction == "" {
+		page.Direction = SortAscending
+	}
+
+	descending := page.Direction == SortDescending
+	at := func(i int) T {
+		if descending {
+			return items[len(items)-1-i]
+		}
+		return items[i]
+	}
+
+	start := 0
+	if page.Cursor != "" {
+		after, err := DecodeCursor(page.Cursor)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		start = sort.Search(len(items), func(i int) bool {
+			cmp := keyOf(at(i)).Compare(after)
+			if descending {
+				return cmp < 0
+			}
+			return cmp > 0
+		})
+	}
+
+	end := start + page.Limit
+	if end > len(items) {
+		end = len(items)
+	}
+	result := make([]T, 0, end-start)
+	for i := start; i < end; i++ {
+		result = append(result, at(i))
+	}
+
+	info := PageInfo{
+		Limit:     page.Limit,
+		Direction: page.Direction,
+		Count:     len(result),
+		Total:     len(items),
+		HasMore:   end < len(items),
+	}
+	if info.HasMore && len(result) > 0 {
+		info.NextCursor = EncodeCursor(keyOf(result[len(result)-1]))
+	}
+
+	return result, info, nil
+}
+
+// compareInts returns -1, 0 or 1 depending on the order of a and b
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,214 @@
+package valueobjects
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		key  CursorKey
+	}{
+		{name: "zero key", key: CursorKey{}},
+		{name: "event position", key: CursorKey{Block: 114018, Index: 1}},
+		{name: "first event of a block", key: CursorKey{Block: 112034}},
+		{name: "identifier", key: CursorKey{ID: "wh_f9de53384f1e28688c9b5188"}},
+		{name: "sequence and identifier", key: CursorKey{Index: 42, ID: "whd_0123"}},
+		{name: "identifier with reserved characters", key: CursorKey{ID: `a/b+c=d"e`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := EncodeCursor(tt.key)
+			if _, err := base64.RawURLEncoding.DecodeString(cursor); err != nil {
+				t.Fatalf("cursor %q is not unpadded URL safe base64: %v", cursor, err)
+			}
+
+			decoded, err := DecodeCursor(cursor)
+			if err != nil {
+				t.Fatalf("DecodeCursor(%q): %v", cursor, err)
+			}
+			if decoded != tt.key {
+				t.Errorf("DecodeCursor(EncodeCursor(%+v)) = %+v", tt.key, decoded)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejectsMalformedCursors(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "not a cursor!"},
+		{name: "padded base64", cursor: base64.URLEncoding.EncodeToString([]byte(`{"b":1}`))},
+		{name: "not JSON", cursor: base64.RawURLEncoding.EncodeToString([]byte("114018-1"))},
+		{name: "wrong field type", cursor: base64.RawURLEncoding.EncodeToString([]byte(`{"b":"114018"}`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeCursor(tt.cursor); !errors.Is(err, ErrInvalidPagination) {
+				t.Errorf("DecodeCursor(%q) error = %v, want ErrInvalidPagination", tt.cursor, err)
+			}
+		})
+	}
+}
+
+func TestCursorKeyCompare(t *testing.T) {
+	tests := []struct {
+		a, b CursorKey
+		want int
+	}{
+		{a: CursorKey{Block: 1}, b: CursorKey{Block: 2}, want: -1},
+		{a: CursorKey{Block: 2, Index: 0}, b: CursorKey{Block: 1, Index: 5}, want: 1},
+		{a: CursorKey{Block: 2, Index: 1}, b: CursorKey{Block: 2, Index: 0}, want: 1},
+		{a: CursorKey{Index: 3, ID: "a"}, b: CursorKey{Index: 3, ID: "b"}, want: -1},
+		{a: CursorKey{Block: 7, Index: 2, ID: "x"}, b: CursorKey{Block: 7, Index: 2, ID: "x"}, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%+v.Compare(%+v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	// Positions as block and index, given out of order
+	items := []CursorKey{
+		{Block: 3}, {Block: 1}, {Block: 2, Index: 1}, {Block: 2}, {Block: 4},
+	}
+	identity := func(key CursorKey) CursorKey { return key }
+
+	tests := []struct {
+		name     string
+		page     PageRequest
+		want     []CursorKey
+		hasMore  bool
+		nextFrom CursorKey
+	}{
+		{
+			name:     "first page ascending",
+			page:     PageRequest{Limit: 2, Direction: SortAscending},
+			want:     []CursorKey{{Block: 1}, {Block: 2}},
+			hasMore:  true,
+			nextFrom: CursorKey{Block: 2},
+		},
+		{
+			name:     "page after a cursor",
+			page:     PageRequest{Limit: 2, Direction: SortAscending, Cursor: EncodeCursor(CursorKey{Block: 2})},
+			want:     []CursorKey{{Block: 2, Index: 1}, {Block: 3}},
+			hasMore:  true,
+			nextFrom: CursorKey{Block: 3},
+		},
+		{
+			name: "last page",
+			page: PageRequest{Limit: 2, Direction: SortAscending, Cursor: EncodeCursor(CursorKey{Block: 3})},
+			want: []CursorKey{{Block: 4}},
+		},
+		{
+			name: "cursor between positions",
+			page: PageRequest{Limit: 10, Cursor: EncodeCursor(CursorKey{Block: 2, Index: 5})},
+			want: []CursorKey{{Block: 3}, {Block: 4}},
+		},
+		{
+			name:     "first page descending",
+			page:     PageRequest{Limit: 2, Direction: SortDescending},
+			want:     []CursorKey{{Block: 4}, {Block: 3}},
+			hasMore:  true,
+			nextFrom: CursorKey{Block: 3},
+		},
+		{
+			name: "page descending after a cursor",
+			page: PageRequest{Limit: 5, Direction: SortDescending, Cursor: EncodeCursor(CursorKey{Block: 2, Index: 1})},
+			want: []CursorKey{{Block: 2}, {Block: 1}},
+		},
+		{
+			name: "cursor past the end",
+			page: PageRequest{Limit: 2, Cursor: EncodeCursor(CursorKey{Block: 9})},
+			want: []CursorKey{},
+		},
+		{
+			name: "default limit and direction",
+			page: PageRequest{},
+			want: []CursorKey{{Block: 1}, {Block: 2}, {Block: 2, Index: 1}, {Block: 3}, {Block: 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, info, err := Paginate(items, identity, tt.page)
+			if err != nil {
+				t.Fatalf("Paginate: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("items = %+v, want %+v", got, tt.want)
+			}
+			if info.Count != len(tt.want) || info.Total != len(items) || info.HasMore != tt.hasMore {
+				t.Errorf("info = %+v, want count %d, total %d, has more %t", info, len(tt.want), len(items), tt.hasMore)
+			}
+
+			if !tt.hasMore {
+				if info.NextCursor != "" {
+					t.Errorf("next cursor = %q on the last page", info.NextCursor)
+				}
+				return
+			}
+			next, err := DecodeCursor(info.NextCursor)
+			if err != nil {
+				t.Fatalf("DecodeCursor(next cursor): %v", err)
+			}
+			if next != tt.nextFrom {
+				t.Errorf("next cursor = %+v, want %+v", next, tt.nextFrom)
+			}
+		})
+	}
+}
+
+func TestPaginateRejectsMalformedCursor(t *testing.T) {
+	_, _, err := Paginate([]CursorKey{{Block: 1}}, func(key CursorKey) CursorKey { return key }, PageRequest{Cursor: "%%%"})
+	if !errors.Is(err, ErrInvalidPagination) {
+		t.Errorf("Paginate error = %v, want ErrInvalidPagination", err)
+	}
+}
+
+func TestNewPageRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     int
+		cursor    string
+		direction SortDirection
+		want      PageRequest
+		wantErr   bool
+	}{
+		{name: "defaults", want: PageRequest{Limit: DefaultPageLimit, Direction: SortAscending}},
+		{name: "descending", limit: 10, direction: SortDescending, want: PageRequest{Limit: 10, Direction: SortDescending}},
+		{name: "maximum limit", limit: MaxPageLimit, want: PageRequest{Limit: MaxPageLimit, Direction: SortAscending}},
+		{name: "negative limit", limit: -1, wantErr: true},
+		{name: "limit above the maximum", limit: MaxPageLimit + 1, wantErr: true},
+		{name: "unknown direction", direction: "up", wantErr: true},
+		{name: "malformed cursor", cursor: "%%%", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPageRequest(tt.limit, tt.cursor, tt.direction)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPagination) {
+					t.Errorf("error = %v, want ErrInvalidPagination", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewPageRequest: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("NewPageRequest = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package input
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+)
+
+// AlertService defines the interface for alert use cases. Creating, updating and
+// deleting alert rules requires the admin scope
+type AlertService interface {
+	// GetAlerts retrieves the firing and resolved alerts matching the query
+	GetAlerts(ctx context.Context, query entities.AlertQuery, page valueobjects.PageRequest) (*Page[entities.Alert], error)
+
+	// GetAlert retrieves an alert
+	GetAlert(ctx context.Context, id string) (*entities.Alert, error)
+
+	// GetAlertRules retrieves the alert rules from the rules file and the API
+	GetAlertRules(ctx context.Context, page valueobjects.PageRequest) (*Page[entities.AlertRule], error)
+
+	// GetAlertRule retrieves an alert rule
+	GetAlertRule(ctx context.Context, id string) (*entities.AlertRule, error)
+
+	// CreateAlertRule creates an alert rule and evaluates it
+	CreateAlertRule(ctx context.Context, request AlertRuleRequest) (*entities.AlertRule, error)
+
+	// UpdateAlertRule replaces an alert rule created through the API and evaluates it
+	UpdateAlertRule(ctx context.Context, id string, request AlertRuleRequest) (*entities.AlertRule, error)
+
+	// DeleteAlertRule deletes an alert rule created through the API, resolving its alerts
+	DeleteAlertRule(ctx context.Context, id string) error
+}
+
+// AlertRuleRequest represents the settings of an alert rule
+type AlertRuleRequest struct {
+	Name        string                    `json:"name"`
+	Description string                    `json:"description,omitempty"`
+	Kind        entities.AlertRuleKind    `json:"kind"`
+	Severity    entities.IncidentSeverity `json:"severity,omitempty"`
+	Threshold   float64                   `json:"threshold,omitempty"`
+	Window      int                       `json:"window,omitempty"`
+	Kinds       []string                  `json:"kinds,omitempty"`
+	Stashes     []string                  `json:"stashes,omitempty"`
+	Enabled     *bool                     `json:"enabled,omitempty"`
+}
@@ -0,0 +1,47 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+)
+
+// APIKeyService defines the interface for API key use cases. Managing keys requires the
+// admin scope
+type APIKeyService interface {
+	// Authenticate returns the principal of an API key secret, counting the request in
+	// the usage of the key
+	Authenticate(ctx context.Context, secret string) (*entities.Principal, error)
+
+	// CreateAPIKey creates an API key, the returned secret is not stored and cannot be
+	// retrieved again
+	CreateAPIKey(ctx context.Context, request APIKeyRequest) (*CreatedAPIKey, error)
+
+	// GetAPIKeys retrieves the API keys
+	GetAPIKeys(ctx context.Context, page valueobjects.PageRequest) (*Page[entities.APIKey], error)
+
+	// GetAPIKey retrieves an API key
+	GetAPIKey(ctx context.Context, id string) (*entities.APIKey, error)
+
+	// UpdateAPIKey replaces the settings of an API key, keeping its secret
+	UpdateAPIKey(ctx context.Context, id string, request APIKeyRequest) (*entities.APIKey, error)
+
+	// DeleteAPIKey revokes an API key
+	DeleteAPIKey(ctx context.Context, id string) error
+}
+
+// APIKeyRequest represents the settings of an API key
+type APIKeyRequest struct {
+	Name      string                 `json:"name"`
+	Scopes    []entities.APIKeyScope `json:"scopes"`
+	RateLimit int                    `json:"rate_limit"`
+	ExpiresAt *time.Time             `json:"expires_at,omitempty"`
+}
+
+// CreatedAPIKey represents a newly created API key along with its secret
+type CreatedAPIKey struct {
+	entities.APIKey
+	Secret string `json:"secret"`
+}
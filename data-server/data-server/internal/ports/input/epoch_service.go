@@ -0,0 +1,14 @@
+package input
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+)
+
+// EpochService defines the interface for consensus epoch use cases
+type EpochService interface {
+	// GetEpochs retrieves the BABE epochs ordered by index
+	GetEpochs(ctx context.Context, page valueobjects.PageRequest) (*Page[entities.Epoch], error)
+}
@@ -4,24 +4,30 @@ import (
 	"context"
 
 	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
 )
 
-// EventService defines the interface for event-related use cases
+// EventService defines the interface for event-related use cases. Lists are ordered by
+// block and event index and returned one page at a time
 type EventService interface {
 	// GetAllEvents retrieves all events
-	GetAllEvents(ctx context.Context) ([]entities.Event, error)
+	GetAllEvents(ctx context.Context, page valueobjects.PageRequest) (*Page[entities.Event], error)
 	
 	// GetEventsByType retrieves events by event type
-	GetEventsByType(ctx context.Context, eventType string) ([]entities.Event, error)
+	GetEventsByType(ctx context.Context, eventType string, page valueobjects.PageRequest) (*Page[entities.Event], error)
 	
 	// GetEventsByBlockRange retrieves events within a block range
-	GetEventsByBlockRange(ctx context.Context, startBlock, endBlock int) ([]entities.Event, error)
+	GetEventsByBlockRange(ctx context.Context, startBlock, endBlock int, page valueobjects.PageRequest) (*Page[entities.Event], error)
 	
-	// GetEventsByCategory retrieves events by category (staking, governance, online, offence)
-	GetEventsByCategory(ctx context.Context, category string) ([]entities.Event, error)
+	// GetEventsByCategory retrieves events by category (staking, governance, online, offence, extrinsic, consensus)
+	GetEventsByCategory(ctx context.Context, category string, page valueobjects.PageRequest) (*Page[entities.Event], error)
 	
-	// GetEventsByValidator retrieves events for a specific validator
-	GetEventsByValidator(ctx context.Context, stash string) ([]entities.Event, error)
+	// GetEventsByValidator retrieves the events of a validator matching the query, failing
+	// with entities.ErrValidatorNotFound if no validator has the given stash
+	GetEventsByValidator(ctx context.Context, stash string, query entities.EventQuery, page valueobjects.PageRequest) (*Page[entities.Event], error)
+	
+	// QueryEvents retrieves events matching every criterion of the query
+	QueryEvents(ctx context.Context, query entities.EventQuery, page valueobjects.PageRequest) (*Page[entities.Event], error)
 	
 	// GetEventStats retrieves statistics about events
 	GetEventStats(ctx context.Context) (*EventStats, error)
@@ -0,0 +1,39 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"data-server/internal/domain/entities"
+)
+
+// ExportService defines the interface for bulk export use cases. Items are passed to the
+// write function one at a time, so an export adds no copy of its result to the memory
+// the repository already holds
+type ExportService interface {
+	// ExportEvents passes the events matching the query to write, ordered by block and
+	// event index. It stops at the first error returned by write
+	ExportEvents(ctx context.Context, query entities.EventQuery, write func(entities.ValidatorEvent) error) error
+
+	// ExportValidators passes a summary of every validator to write, ordered by type
+	ExportValidators(ctx context.Context, write func(ValidatorSummary) error) error
+}
+
+// ValidatorSummary represents the flat summary of a validator exported as a single row
+type ValidatorSummary struct {
+	Stash            string              `json:"stash"`
+	Type             string              `json:"type"`
+	Description      string              `json:"description"`
+	TotalEvents      int                 `json:"total_events"`
+	StakingEvents    int                 `json:"staking_events"`
+	GovernanceEvents int                 `json:"governance_events"`
+	OnlineEvents     int                 `json:"online_events"`
+	OffenceEvents    int                 `json:"offence_events"`
+	TotalRewards     int64               `json:"total_rewards"`
+	IsActive         bool                `json:"is_active"`
+	HasBeenSlashed   bool                `json:"has_been_slashed"`
+	StakeState       entities.StakeState `json:"stake_state"`
+	BondedStake      int64               `json:"bonded_stake"`
+	LatestBlock      int                 `json:"latest_block"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+}
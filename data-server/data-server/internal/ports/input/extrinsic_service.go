@@ -0,0 +1,26 @@
+package input
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+)
+
+// ExtrinsicService defines the interface for extrinsic analytics use cases
+type ExtrinsicService interface {
+	// GetExtrinsicFailures retrieves extrinsic failure analytics per account
+	GetExtrinsicFailures(ctx context.Context, filter ExtrinsicFailureFilter) (*entities.ExtrinsicFailureReport, error)
+}
+
+// ExtrinsicFailureFilter represents the filters applied to extrinsic failure analytics
+type ExtrinsicFailureFilter struct {
+	// Validator limits the analytics to a validator, given by type or stash address
+	Validator string
+
+	// BlockRange limits the analytics to a block range
+	BlockRange *valueobjects.BlockRange
+
+	// BucketSize is the number of blocks covered by a trend bucket
+	BucketSize int
+}
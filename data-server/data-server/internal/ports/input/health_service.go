@@ -0,0 +1,13 @@
+package input
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+)
+
+// HealthService defines the interface for health monitoring use cases
+type HealthService interface {
+	// CheckHealth runs the registered health checks and reports the health of the server
+	CheckHealth(ctx context.Context) (*entities.HealthReport, error)
+}
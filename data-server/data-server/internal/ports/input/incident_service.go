@@ -0,0 +1,17 @@
+package input
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+)
+
+// IncidentService defines the interface for incident-related use cases
+type IncidentService interface {
+	// GetIncidents retrieves the incidents of all validators at or above the given severity
+	GetIncidents(ctx context.Context, minSeverity entities.IncidentSeverity, page valueobjects.PageRequest) (*Page[entities.Incident], error)
+
+	// GetValidatorIncidents retrieves the incidents of a specific validator
+	GetValidatorIncidents(ctx context.Context, validatorType string, page valueobjects.PageRequest) (*Page[entities.Incident], error)
+}
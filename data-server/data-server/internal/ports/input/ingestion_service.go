@@ -0,0 +1,14 @@
+package input
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+)
+
+// IngestionService defines the interface for ingestion monitoring use cases
+type IngestionService interface {
+	// GetIngestionStatus retrieves the latest stored block and, when the chain head is
+	// known, how far the stored data trails it
+	GetIngestionStatus(ctx context.Context) (*entities.IngestionStatus, error)
+}
@@ -0,0 +1,26 @@
+package input
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+)
+
+// OffenceService defines the interface for offence analytics use cases
+type OffenceService interface {
+	// GetOffenceReport retrieves offence analytics for the offences matching the filter
+	GetOffenceReport(ctx context.Context, filter OffenceFilter) (*entities.OffenceReport, error)
+}
+
+// OffenceFilter represents the filters applied before computing offence analytics
+type OffenceFilter struct {
+	// Kind limits the offences to a single kind (offline, equivocation, grandpa)
+	Kind string
+
+	// Validator limits the offences to a validator, given by type or stash address
+	Validator string
+
+	// BlockRange limits the offences to a block range
+	BlockRange *valueobjects.BlockRange
+}
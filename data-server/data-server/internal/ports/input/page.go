@@ -0,0 +1,9 @@
+package input
+
+import "data-server/internal/domain/valueobjects"
+
+// Page represents a page of a list returned by a use case
+type Page[T any] struct {
+	Items []T                   `json:"items"`
+	Info  valueobjects.PageInfo `json:"pagination"`
+}
@@ -0,0 +1,18 @@
+package input
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+)
+
+// StreamService defines the interface for live event streaming use cases
+type StreamService interface {
+	// StreamEvents streams the events matching the query as they are saved. When after is
+	// set, the stored events following that position are replayed first. The channel is
+	// closed when the context is done, or when the subscriber falls too far behind, the
+	// filter fails on a live event or the server shuts down, in which cases it can resume
+	// from the last received event
+	StreamEvents(ctx context.Context, query entities.EventQuery, after *valueobjects.CursorKey) (<-chan entities.ValidatorEvent, error)
+}
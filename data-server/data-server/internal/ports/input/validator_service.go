@@ -4,27 +4,40 @@ import (
 	"context"
 
 	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
 )
 
 // ValidatorService defines the interface for validator-related use cases
 type ValidatorService interface {
 	// GetAllValidators retrieves all validators
-	GetAllValidators(ctx context.Context) ([]*entities.Validator, error)
+	GetAllValidators(ctx context.Context, page valueobjects.PageRequest) (*Page[*entities.Validator], error)
 	
 	// GetValidatorByType retrieves a validator by its type
 	GetValidatorByType(ctx context.Context, validatorType string) (*entities.Validator, error)
 	
 	// GetValidatorEvents retrieves events for a specific validator
-	GetValidatorEvents(ctx context.Context, validatorType string) ([]entities.Event, error)
+	GetValidatorEvents(ctx context.Context, validatorType string, page valueobjects.PageRequest) (*Page[entities.Event], error)
 	
 	// GetValidatorEventsByType retrieves events of a specific type for a validator
-	GetValidatorEventsByType(ctx context.Context, validatorType, eventType string) ([]entities.Event, error)
+	GetValidatorEventsByType(ctx context.Context, validatorType, eventType string, page valueobjects.PageRequest) (*Page[entities.Event], error)
 	
 	// GetValidatorEventsByBlockRange retrieves events within a block range for a validator
-	GetValidatorEventsByBlockRange(ctx context.Context, validatorType string, startBlock, endBlock int) ([]entities.Event, error)
+	GetValidatorEventsByBlockRange(ctx context.Context, validatorType string, startBlock, endBlock int, page valueobjects.PageRequest) (*Page[entities.Event], error)
+	
+	// QueryValidatorEvents retrieves events of a validator matching every criterion of the query
+	QueryValidatorEvents(ctx context.Context, validatorType string, query entities.EventQuery, page valueobjects.PageRequest) (*Page[entities.Event], error)
 	
 	// GetValidatorStats retrieves statistics for a validator
 	GetValidatorStats(ctx context.Context, validatorType string) (*ValidatorStats, error)
+	
+	// GetValidatorPayouts reconciles the era payouts of a validator
+	GetValidatorPayouts(ctx context.Context, validatorType string) (*entities.PayoutReport, error)
+	
+	// GetValidatorStake replays the bonded balance and lifecycle of a validator
+	GetValidatorStake(ctx context.Context, validatorType string) (*entities.StakeLedger, error)
+	
+	// GetDataVersion retrieves the version of the validator data, for caching responses derived from it
+	GetDataVersion(ctx context.Context) (*entities.DataVersion, error)
 }
 
 // ValidatorStats represents statistics for a validator
@@ -37,4 +50,9 @@ type ValidatorStats struct {
 	TotalRewards    int64 `json:"total_rewards"`
 	IsActive        bool  `json:"is_active"`
 	HasBeenSlashed  bool  `json:"has_been_slashed"`
+	Payouts         entities.PayoutSummary `json:"payouts"`
+	StakeState      entities.StakeState    `json:"stake_state"`
+	BondedStake     int64                  `json:"bonded_stake"`
+	OffencesByKind  map[string]int         `json:"offences_by_kind"`
+	Consensus       entities.ConsensusParticipation `json:"consensus"`
 } 
\ No newline at end of file
@@ -0,0 +1,43 @@
+package input
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
+)
+
+// WebhookService defines the interface for webhook subscription use cases. Every
+// operation requires the admin scope
+type WebhookService interface {
+	// CreateWebhook creates a webhook subscription, generating a secret if none is given
+	CreateWebhook(ctx context.Context, request WebhookRequest) (*entities.WebhookSubscription, error)
+
+	// GetWebhooks retrieves the webhook subscriptions
+	GetWebhooks(ctx context.Context, page valueobjects.PageRequest) (*Page[entities.WebhookSubscription], error)
+
+	// GetWebhook retrieves a webhook subscription
+	GetWebhook(ctx context.Context, id string) (*entities.WebhookSubscription, error)
+
+	// UpdateWebhook replaces the settings of a webhook subscription, enabling it again
+	// resets its failure counter
+	UpdateWebhook(ctx context.Context, id string, request WebhookRequest) (*entities.WebhookSubscription, error)
+
+	// DeleteWebhook deletes a webhook subscription and its deliveries
+	DeleteWebhook(ctx context.Context, id string) error
+
+	// GetWebhookDeliveries retrieves the delivery log of a webhook subscription
+	GetWebhookDeliveries(ctx context.Context, id string, page valueobjects.PageRequest) (*Page[entities.WebhookDelivery], error)
+
+	// PingWebhook queues a test delivery for a webhook subscription
+	PingWebhook(ctx context.Context, id string) (*entities.WebhookDelivery, error)
+}
+
+// WebhookRequest represents the settings of a webhook subscription
+type WebhookRequest struct {
+	URL         string                 `json:"url"`
+	Secret      string                 `json:"secret,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Filter      entities.WebhookFilter `json:"filter"`
+	Enabled     *bool                  `json:"enabled,omitempty"`
+}
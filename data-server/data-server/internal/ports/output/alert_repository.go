@@ -0,0 +1,34 @@
+package output
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+)
+
+// AlertRuleRepository defines the interface for API managed alert rule data access
+type AlertRuleRepository interface {
+	// GetAllRules retrieves all alert rules
+	GetAllRules(ctx context.Context) ([]entities.AlertRule, error)
+
+	// GetRuleByID retrieves an alert rule by its identifier
+	GetRuleByID(ctx context.Context, id string) (*entities.AlertRule, error)
+
+	// SaveRule creates or replaces an alert rule
+	SaveRule(ctx context.Context, rule entities.AlertRule) error
+
+	// DeleteRule deletes an alert rule
+	DeleteRule(ctx context.Context, id string) error
+}
+
+// AlertRepository defines the interface for alert data access
+type AlertRepository interface {
+	// GetAll retrieves all firing and resolved alerts
+	GetAll(ctx context.Context) ([]entities.Alert, error)
+
+	// GetByID retrieves an alert by its identifier
+	GetByID(ctx context.Context, id string) (*entities.Alert, error)
+
+	// Save creates or replaces alerts
+	Save(ctx context.Context, alerts ...entities.Alert) error
+}
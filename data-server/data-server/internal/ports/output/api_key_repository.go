@@ -0,0 +1,25 @@
+package output
+
+import (
+	"context"
+
+	"data-server/internal/domain/entities"
+)
+
+// APIKeyRepository defines the interface for API key data access
+type APIKeyRepository interface {
+	// GetAll retrieves all API keys
+	GetAll(ctx context.Context) ([]entities.APIKey, error)
+
+	// GetByID retrieves an API key by its identifier
+	GetByID(ctx context.Context, id string) (*entities.APIKey, error)
+
+	// GetByHash retrieves an API key by the hash of its secret
+	GetByHash(ctx context.Context, hash string) (*entities.APIKey, error)
+
+	// Save creates or replaces an API key
+	Save(ctx context.Context, key entities.APIKey) error
+
+	// Delete deletes an API key
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,11 @@
+package output
+
+import (
+	"context"
+)
+
+// ChainHeadSource defines the interface for reading the head of the chain
+type ChainHeadSource interface {
+	// ChainHead returns the number of the latest block of the chain
+	ChainHead(ctx context.Context) (int, error)
+}
@@ -0,0 +1,22 @@
+package output
+
+import "data-server/internal/domain/entities"
+
+// EventBus defines the interface for publishing saved events to live subscribers
+type EventBus interface {
+	// Publish delivers events to every subscriber
+	Publish(events ...entities.ValidatorEvent)
+
+	// Subscribe registers a subscriber that may have up to buffer events pending
+	Subscribe(buffer int) Subscription
+}
+
+// Subscription represents a subscriber of the event bus
+type Subscription interface {
+	// Events returns the channel events are delivered on. It is closed when the
+	// subscription is cancelled or the subscriber falls too far behind
+	Events() <-chan entities.ValidatorEvent
+
+	// Cancel unregisters the subscriber
+	Cancel()
+}
@@ -0,0 +1,9 @@
+package output
+
+import "context"
+
+// HealthChecker defines the interface of the adapters and workers reporting their health
+type HealthChecker interface {
+	// CheckHealth returns an error describing why the component is unhealthy, or nil
+	CheckHealth(ctx context.Context) error
+}
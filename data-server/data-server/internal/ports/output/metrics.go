@@ -0,0 +1,18 @@
+package output
+
+import (
+	"time"
+)
+
+// Metrics defines the interface for recording the measurements of the service, which
+// adapters export to a monitoring system
+type Metrics interface {
+	// ObserveHTTPRequest records an HTTP request served by a route
+	ObserveHTTPRequest(method, route string, status int, duration time.Duration)
+
+	// ObserveRepositoryOperation records a call of a repository and its error, if any
+	ObserveRepositoryOperation(repository, operation string, duration time.Duration, err error)
+
+	// ObserveUseCaseOperation records a call of a use case and its error, if any
+	ObserveUseCaseOperation(useCase, operation string, duration time.Duration, err error)
+}
@@ -0,0 +1,19 @@
+package output
+
+import (
+	"context"
+)
+
+// Tracer defines the interface for tracing the operations of the service, which adapters
+// export to a tracing system
+type Tracer interface {
+	// Start starts a span of an operation, child of the span carried by the context, and
+	// returns a copy of the context carrying the new span
+	Start(ctx context.Context, name string, attributes map[string]string) (context.Context, Span)
+}
+
+// Span defines the interface of a traced operation
+type Span interface {
+	// End ends the span, recording the error of the operation if any
+	End(err error)
+}
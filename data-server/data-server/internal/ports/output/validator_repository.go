@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"data-server/internal/domain/entities"
+	"data-server/internal/domain/valueobjects"
 )
 
 // ValidatorRepository defines the interface for validator data access
@@ -11,15 +12,31 @@ type ValidatorRepository interface {
 	// GetAll retrieves all validators
 	GetAll(ctx context.Context) ([]*entities.Validator, error)
 	
+	// GetPage retrieves the requested page of validators, ordered by type
+	GetPage(ctx context.Context, page valueobjects.PageRequest) ([]*entities.Validator, valueobjects.PageInfo, error)
+	
 	// GetByType retrieves a validator by its type
 	GetByType(ctx context.Context, validatorType string) (*entities.Validator, error)
 	
 	// GetByStash retrieves a validator by its stash address
 	GetByStash(ctx context.Context, stash string) (*entities.Validator, error)
 	
-	// Save saves a validator
+	// GetEvents retrieves a snapshot of the events of all validators, ordered by block and
+	// event index. The snapshot is not affected by later saves and must not be modified
+	GetEvents(ctx context.Context) ([]entities.ValidatorEvent, error)
+	
+	// QueryEvents retrieves the requested page of the events of all validators matching
+	// every criterion of the query, ordered by block and event index
+	QueryEvents(ctx context.Context, query entities.EventQuery, page valueobjects.PageRequest) ([]entities.Event, valueobjects.PageInfo, error)
+	
+	// Save saves a validator. Stored events are only appended to, saving a validator
+	// whose stored events were modified or removed fails with ErrEventsRewritten
 	Save(ctx context.Context, validator *entities.Validator) error
 	
-	// Update updates a validator
+	// Update updates a validator, failing like Save when its stored events were modified
+	// or removed
 	Update(ctx context.Context, validator *entities.Validator) error
+	
+	// Version retrieves the version of the validator data, which changes on every save or update
+	Version(ctx context.Context) (*entities.DataVersion, error)
 } 
\ No newline at end of file
@@ -0,0 +1,38 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"data-server/internal/domain/entities"
+)
+
+// WebhookRepository defines the interface for webhook subscription data access
+type WebhookRepository interface {
+	// GetAll retrieves all webhook subscriptions
+	GetAll(ctx context.Context) ([]entities.WebhookSubscription, error)
+
+	// GetByID retrieves a webhook subscription by its identifier
+	GetByID(ctx context.Context, id string) (*entities.WebhookSubscription, error)
+
+	// Save creates or replaces a webhook subscription
+	Save(ctx context.Context, subscription entities.WebhookSubscription) error
+
+	// Delete deletes a webhook subscription and its deliveries
+	Delete(ctx context.Context, id string) error
+}
+
+// WebhookOutbox defines the interface for the persistent queue of webhook deliveries
+type WebhookOutbox interface {
+	// Enqueue stores new deliveries, assigning their sequence numbers in place
+	Enqueue(ctx context.Context, deliveries ...entities.WebhookDelivery) error
+
+	// GetDue retrieves up to limit pending deliveries whose next attempt is due
+	GetDue(ctx context.Context, now time.Time, limit int) ([]entities.WebhookDelivery, error)
+
+	// GetBySubscription retrieves the deliveries of a webhook subscription
+	GetBySubscription(ctx context.Context, subscriptionID string) ([]entities.WebhookDelivery, error)
+
+	// SaveDelivery replaces a stored delivery
+	SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) error
+}
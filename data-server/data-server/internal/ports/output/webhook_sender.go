@@ -0,0 +1,10 @@
+package output
+
+import "context"
+
+// WebhookSender defines the interface for sending webhook requests to subscribers
+type WebhookSender interface {
+	// Send posts the body with the given headers to the URL and returns the response
+	// status code. Non 2xx responses are returned as errors
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+}
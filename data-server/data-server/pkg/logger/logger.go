@@ -0,0 +1,138 @@
+package logger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+const (
+	// maxRequestIDLength is the maximum length of a request identifier given by a client
+	maxRequestIDLength = 128
+
+	// FormatJSON writes every record as a JSON object on its own line
+	FormatJSON = "json"
+	// FormatText writes every record as key=value pairs on its own line
+	FormatText = "text"
+)
+
+// fieldsKey is the context key of the log fields
+type fieldsKey struct{}
+
+// requestIDKey is the context key of the request identifier
+type requestIDKey struct{}
+
+// New creates a logger writing records of at least the given level in the given
+// format. The fields attached to the context of a record are added to it
+func New(w io.Writer, level slog.Level, format string) (*slog.Logger, error) {
+	options := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch format {
+	case FormatJSON, "":
+		handler = slog.NewJSONHandler(w, options)
+	case FormatText:
+		handler = slog.NewTextHandler(w, options)
+	default:
+		return nil, fmt.Errorf("unknown log format %q, expected %s or %s", format, FormatJSON, FormatText)
+	}
+
+	return slog.New(&contextHandler{Handler: handler}), nil
+}
+
+// ParseLevel returns the level named debug, info, warn or error, case insensitively
+func ParseLevel(name string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil {
+		return 0, fmt.Errorf("unknown log level %q, expected debug, info, warn or error", name)
+	}
+	return level, nil
+}
+
+// With returns a copy of the context carrying the given fields in addition to those it
+// already carries, for every record logged with the context
+func With(ctx context.Context, attrs ...slog.Attr) context.Context {
+	if len(attrs) == 0 {
+		return ctx
+	}
+
+	existing := fields(ctx)
+	merged := make([]slog.Attr, 0, len(existing)+len(attrs))
+	merged = append(merged, existing...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+// WithRequestID returns a copy of the context carrying the identifier of a request,
+// logged as the request_id field
+func WithRequestID(ctx context.Context, id string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+	return With(ctx, slog.String("request_id", id))
+}
+
+// RequestID returns the identifier of the request carried by the context, or "" if it
+// carries none
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// NewRequestID returns a random request identifier
+func NewRequestID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf)
+}
+
+// ValidRequestID returns true if a request identifier given by a client may be used, so
+// that clients cannot inject arbitrary text into the logs
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// fields returns the fields carried by the context
+func fields(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	attrs, _ := ctx.Value(fieldsKey{}).([]slog.Attr)
+	return attrs
+}
+
+// contextHandler adds the fields carried by the context of a record to the record
+type contextHandler struct {
+	slog.Handler
+}
+
+// Handle adds the context fields to the record and passes it to the wrapped handler
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if attrs := fields(ctx); len(attrs) > 0 {
+		record = record.Clone()
+		record.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs returns a handler adding the given attributes to every record
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup returns a handler nesting the attributes of every record in a group
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
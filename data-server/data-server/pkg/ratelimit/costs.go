@@ -0,0 +1,37 @@
+package ratelimit
+
+// Costs weighs the operations of the APIs by the work they cause, in tokens of the rate
+// limits. HTTP routes are keyed by their pattern, e.g. /api/v1/events/stats, and gRPC
+// methods by their full method name, e.g. /blockchain.v1.EventService/GetEventStats
+type Costs struct {
+	// Operations are the costs of operations, other operations cost one token
+	Operations map[string]int
+	// BlockRangeSpan is the number of blocks of a block range costing one more token,
+	// zero disables the block range costs
+	BlockRangeSpan int
+	// Max caps the cost of an operation
+	Max int
+}
+
+// Cost returns the cost of an operation over a block range of the given number of
+// blocks, zero when it has none: the cost of the operation plus one token per span
+func (c Costs) Cost(operation string, blocks int) int {
+	cost, ok := c.Operations[operation]
+	if !ok {
+		cost = 1
+	}
+
+	if c.BlockRangeSpan > 0 && blocks > 0 {
+		cost += blocks / c.BlockRangeSpan
+	}
+
+	return c.Cap(cost)
+}
+
+// Cap returns a cost lowered to the maximum cost of an operation
+func (c Costs) Cap(cost int) int {
+	if c.Max > 0 && cost > c.Max {
+		return c.Max
+	}
+	return cost
+}
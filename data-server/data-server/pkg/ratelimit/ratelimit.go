@@ -0,0 +1,168 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// pruneInterval is how often idle buckets are removed
+	pruneInterval = time.Minute
+)
+
+// Rate represents the refill rate and capacity of a token bucket. A rate of zero or less
+// per minute is unlimited
+type Rate struct {
+	PerMinute int
+	Burst     int
+}
+
+// Unlimited returns true if the rate does not limit requests
+func (r Rate) Unlimited() bool {
+	return r.PerMinute <= 0
+}
+
+// Window returns the time an empty bucket takes to refill
+func (r Rate) Window() time.Duration {
+	return r.duration(r.capacity())
+}
+
+// capacity returns the number of tokens a full bucket holds
+func (r Rate) capacity() float64 {
+	if r.Burst <= 0 {
+		return float64(r.PerMinute)
+	}
+	return float64(r.Burst)
+}
+
+// perSecond returns the number of tokens added to a bucket every second
+func (r Rate) perSecond() float64 {
+	return float64(r.PerMinute) / 60
+}
+
+// duration returns the time needed to refill the given number of tokens
+func (r Rate) duration(tokens float64) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+	return time.Duration(tokens / r.perSecond() * float64(time.Second))
+}
+
+// Policy represents the rates of the clients of the API
+type Policy struct {
+	IP  Rate
+	Key Rate
+}
+
+// Client returns the bucket and rate of a client, identified by its API key if it has
+// one and by its IP address otherwise. The rate limit of a key, if above zero, replaces
+// the refill rate of the key rate, the burst being capped to it
+func (p Policy) Client(keyID string, keyRateLimit int, ip string) (string, Rate) {
+	if keyID == "" {
+		return "ip:" + ip, p.IP
+	}
+
+	rate := p.Key
+	if keyRateLimit > 0 {
+		rate.PerMinute = keyRateLimit
+		if rate.Burst <= 0 || rate.Burst > keyRateLimit {
+			rate.Burst = keyRateLimit
+		}
+	}
+	return "key:" + keyID, rate
+}
+
+// Decision represents the outcome of taking tokens from a bucket
+type Decision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+// bucket represents the tokens left to a client
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	rate    Rate
+}
+
+// Limiter keeps a token bucket per client. Buckets are created full, refilled
+// continuously at their rate and removed once they are full again
+type Limiter struct {
+	buckets map[string]*bucket
+	mutex   sync.Mutex
+}
+
+// NewLimiter creates a new limiter
+func NewLimiter() *Limiter {
+	return &Limiter{
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Allow takes cost tokens from the bucket of a client, if it holds enough. A cost above
+// the capacity of the bucket is lowered to the capacity, so that expensive requests are
+// slowed down rather than rejected forever
+func (l *Limiter) Allow(client string, rate Rate, cost int) Decision {
+	if rate.Unlimited() {
+		return Decision{Allowed: true}
+	}
+
+	capacity := rate.capacity()
+	need := math.Min(float64(cost), capacity)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	b, ok := l.buckets[client]
+	if !ok || b.rate != rate {
+		b = &bucket{tokens: capacity, updated: now, rate: rate}
+		l.buckets[client] = b
+	}
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.updated).Seconds()*rate.perSecond())
+	b.updated = now
+
+	decision := Decision{Limit: int(capacity)}
+	if b.tokens >= need {
+		b.tokens -= need
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = rate.duration(need - b.tokens)
+	}
+	decision.Remaining = int(b.tokens)
+	decision.Reset = rate.duration(capacity - b.tokens)
+	return decision
+}
+
+// Run removes the buckets refilled to their capacity, which are the same as new ones,
+// until the context is canceled
+func (l *Limiter) Run(ctx context.Context) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.prune(time.Now())
+		}
+	}
+}
+
+// prune removes the buckets refilled to their capacity at the given time
+func (l *Limiter) prune(now time.Time) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for client, b := range l.buckets {
+		if b.tokens+now.Sub(b.updated).Seconds()*b.rate.perSecond() >= b.rate.capacity() {
+			delete(l.buckets, client)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiterAllow(t *testing.T) {
+	type take struct {
+		cost      int
+		allowed   bool
+		remaining int
+	}
+
+	tests := []struct {
+		name  string
+		rate  Rate
+		takes []take
+	}{
+		{
+			name: "a full bucket holds the burst",
+			rate: Rate{PerMinute: 60, Burst: 3},
+			takes: []take{
+				{cost: 1, allowed: true, remaining: 2},
+				{cost: 1, allowed: true, remaining: 1},
+				{cost: 1, allowed: true, remaining: 0},
+				{cost: 1, allowed: false, remaining: 0},
+			},
+		},
+		{
+			name: "the burst defaults to the rate",
+			rate: Rate{PerMinute: 2},
+			takes: []take{
+				{cost: 1, allowed: true, remaining: 1},
+				{cost: 1, allowed: true, remaining: 0},
+				{cost: 1, allowed: false, remaining: 0},
+			},
+		},
+		{
+			name: "costs take several tokens",
+			rate: Rate{PerMinute: 60, Burst: 10},
+			takes: []take{
+				{cost: 4, allowed: true, remaining: 6},
+				{cost: 5, allowed: true, remaining: 1},
+				{cost: 2, allowed: false, remaining: 1},
+				{cost: 1, allowed: true, remaining: 0},
+			},
+		},
+		{
+			name: "a cost above the capacity is lowered to the capacity",
+			rate: Rate{PerMinute: 60, Burst: 5},
+			takes: []take{
+				{cost: 50, allowed: true, remaining: 0},
+				{cost: 50, allowed: false, remaining: 0},
+			},
+		},
+		{
+			name: "an unlimited rate allows everything",
+			rate: Rate{},
+			takes: []take{
+				{cost: 1000, allowed: true},
+				{cost: 1000, allowed: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := NewLimiter()
+			for i, take := range tt.takes {
+				decision := limiter.Allow("ip:192.0.2.1", tt.rate, take.cost)
+				if decision.Allowed != take.allowed || decision.Remaining != take.remaining {
+					t.Errorf("take %d of %d = allowed %t with %d remaining, want allowed %t with %d remaining",
+						i, take.cost, decision.Allowed, decision.Remaining, take.allowed, take.remaining)
+				}
+				if !decision.Allowed && decision.RetryAfter <= 0 {
+					t.Errorf("take %d was rejected without a retry delay", i)
+				}
+			}
+		})
+	}
+}
+
+func TestLimiterRefill(t *testing.T) {
+	rate := Rate{PerMinute: 60, Burst: 10}
+
+	tests := []struct {
+		name      string
+		elapsed   time.Duration
+		cost      int
+		allowed   bool
+		remaining int
+	}{
+		{name: "no time elapsed", elapsed: 0, cost: 1, allowed: false, remaining: 0},
+		{name: "one token per second", elapsed: 3 * time.Second, cost: 3, allowed: true, remaining: 0},
+		{name: "partial refill", elapsed: 3 * time.Second, cost: 4, allowed: false, remaining: 3},
+		{name: "refilled to the capacity only", elapsed: time.Hour, cost: 1, allowed: true, remaining: 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := NewLimiter()
+			client := "key:k_test"
+			limiter.Allow(client, rate, rate.Burst)
+
+			limiter.buckets[client].updated = limiter.buckets[client].updated.Add(-tt.elapsed)
+
+			decision := limiter.Allow(client, rate, tt.cost)
+			if decision.Allowed != tt.allowed || decision.Remaining != tt.remaining {
+				t.Errorf("decision = allowed %t with %d remaining, want allowed %t with %d remaining",
+					decision.Allowed, decision.Remaining, tt.allowed, tt.remaining)
+			}
+		})
+	}
+}
+
+func TestLimiterRetryAfter(t *testing.T) {
+	limiter := NewLimiter()
+	rate := Rate{PerMinute: 60, Burst: 2}
+	limiter.Allow("ip:192.0.2.1", rate, 2)
+
+	decision := limiter.Allow("ip:192.0.2.1", rate, 2)
+	if decision.Allowed {
+		t.Fatal("empty bucket allowed a request")
+	}
+	// Two tokens at one per second, less the time elapsed since the bucket was emptied
+	if decision.RetryAfter > 2*time.Second || decision.RetryAfter < 2*time.Second-100*time.Millisecond {
+		t.Errorf("retry after = %s, want about 2s", decision.RetryAfter)
+	}
+	if decision.Limit != 2 {
+		t.Errorf("limit = %d, want 2", decision.Limit)
+	}
+}
+
+func TestLimiterPrune(t *testing.T) {
+	limiter := NewLimiter()
+	rate := Rate{PerMinute: 60, Burst: 10}
+	limiter.Allow("ip:full", rate, 1)
+	limiter.Allow("ip:empty", rate, 10)
+
+	limiter.prune(time.Now().Add(2 * time.Second))
+
+	if _, ok := limiter.buckets["ip:full"]; ok {
+		t.Error("bucket refilled to its capacity was not pruned")
+	}
+	if _, ok := limiter.buckets["ip:empty"]; !ok {
+		t.Error("bucket still refilling was pruned")
+	}
+}
+
+func TestPolicyClient(t *testing.T) {
+	policy := Policy{
+		IP:  Rate{PerMinute: 60, Burst: 20},
+		Key: Rate{PerMinute: 600, Burst: 100},
+	}
+
+	tests := []struct {
+		name         string
+		keyID        string
+		keyRateLimit int
+		wantClient   string
+		wantRate     Rate
+	}{
+		{name: "anonymous client", wantClient: "ip:192.0.2.1", wantRate: policy.IP},
+		{name: "key without its own limit", keyID: "k_1", wantClient: "key:k_1", wantRate: policy.Key},
+		{name: "key limit above the burst", keyID: "k_1", keyRateLimit: 1200, wantClient: "key:k_1", wantRate: Rate{PerMinute: 1200, Burst: 100}},
+		{name: "key limit below the burst caps it", keyID: "k_1", keyRateLimit: 30, wantClient: "key:k_1", wantRate: Rate{PerMinute: 30, Burst: 30}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, rate := policy.Client(tt.keyID, tt.keyRateLimit, "192.0.2.1")
+			if client != tt.wantClient || rate != tt.wantRate {
+				t.Errorf("Client = %s %+v, want %s %+v", client, rate, tt.wantClient, tt.wantRate)
+			}
+		})
+	}
+}
+
+func TestCostsCost(t *testing.T) {
+	costs := Costs{
+		Operations: map[string]int{
+			"/api/v1/events/stats":                   10,
+			"/blockchain.v1.EventService/ListEvents": 1,
+		},
+		BlockRangeSpan: 1000,
+		Max:            50,
+	}
+
+	tests := []struct {
+		name      string
+		costs     Costs
+		operation string
+		blocks    int
+		want      int
+	}{
+		{name: "unknown operation", costs: costs, operation: "/api/v1/validators", want: 1},
+		{name: "weighted route", costs: costs, operation: "/api/v1/events/stats", want: 10},
+		{name: "gRPC method", costs: costs, operation: "/blockchain.v1.EventService/ListEvents", blocks: 20000, want: 21},
+		{name: "range below a span", costs: costs, operation: "/api/v1/events", blocks: 999, want: 1},
+		{name: "range widening a weighted route", costs: costs, operation: "/api/v1/events/stats", blocks: 5000, want: 15},
+		{name: "capped cost", costs: costs, operation: "/api/v1/events", blocks: 1000000, want: 50},
+		{name: "block range costs disabled", costs: Costs{}, operation: "/api/v1/events", blocks: 1000000, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.costs.Cost(tt.operation, tt.blocks); got != tt.want {
+				t.Errorf("Cost(%s, %d) = %d, want %d", tt.operation, tt.blocks, got, tt.want)
+			}
+		})
+	}
+}
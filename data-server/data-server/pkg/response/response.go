@@ -1,39 +1,111 @@
 package response
 
 import (
+	"errors"
+	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
 )
 
+// tracer traces the encoding of the responses
+var tracer = otel.Tracer("data-server/pkg/response")
+
 // APIResponse represents the standard API response structure
 type APIResponse struct {
-	Success bool        `json:"success"`
-	Data    interface{} `json:"data,omitempty"`
-	Error   string      `json:"error,omitempty"`
-	Message string      `json:"message,omitempty"`
+	Success    bool        `json:"success"`
+	Data       interface{} `json:"data,omitempty"`
+	Pagination interface{} `json:"pagination,omitempty"`
+	Error      string      `json:"error,omitempty"`
+	Message    string      `json:"message,omitempty"`
 }
 
 // Success sends a successful response
 func Success(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusOK, APIResponse{
+	render(c, http.StatusOK, APIResponse{
 		Success: true,
 		Data:    data,
 	})
 }
 
-// Error sends an error response
+// SuccessWithPagination sends a successful response for a page of a list
+func SuccessWithPagination(c *gin.Context, data interface{}, pagination interface{}) {
+	render(c, http.StatusOK, APIResponse{
+		Success:    true,
+		Data:       data,
+		Pagination: pagination,
+	})
+}
+
+// ProblemContentType is the media type of RFC 7807 problem details
+const ProblemContentType = "application/problem+json"
+
+// Problem represents the RFC 7807 problem details of an error response. Code is a
+// stable machine readable identifier of the error, also found at the end of Type
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+}
+
+// coded is implemented by errors carrying a stable error code
+type coded interface {
+	ErrorCode() string
+}
+
+// Error sends an error response as problem details. The code is taken from the error
+// if it carries one, and from the status code otherwise. The error message is only
+// disclosed for client errors, server errors are logged instead
 func Error(c *gin.Context, statusCode int, message string, err error) {
-	errorMsg := message
+	problem := Problem{
+		Title:  message,
+		Status: statusCode,
+		Code:   StatusCode(statusCode),
+	}
+
+	var codedErr coded
+	if errors.As(err, &codedErr) {
+		problem.Code = codedErr.ErrorCode()
+	}
 	if err != nil {
-		errorMsg = err.Error()
+		if statusCode < http.StatusInternalServerError {
+			problem.Detail = err.Error()
+		} else {
+			slog.ErrorContext(c.Request.Context(), message, "status", statusCode, "error", err)
+		}
 	}
-	
-	c.JSON(statusCode, APIResponse{
-		Success: false,
-		Error:   errorMsg,
-		Message: message,
-	})
+
+	WriteProblem(c, problem)
+}
+
+// WriteProblem sends problem details, filling in their type and instance
+func WriteProblem(c *gin.Context, problem Problem) {
+	if problem.Type == "" {
+		problem.Type = "urn:problem-type:" + problem.Code
+	}
+	if problem.Instance == "" {
+		problem.Instance = c.Request.URL.Path
+	}
+
+	c.Header("Content-Type", ProblemContentType)
+	c.AbortWithStatusJSON(problem.Status, problem)
+}
+
+// StatusCode returns the generic error code of an HTTP status code, e.g. "not_found"
+func StatusCode(statusCode int) string {
+	text := http.StatusText(statusCode)
+	if text == "" {
+		return "error"
+	}
+	return strings.ReplaceAll(strings.ToLower(text), " ", "_")
 }
 
 // BadRequest sends a bad request response
@@ -59,4 +131,36 @@ func Unauthorized(c *gin.Context, message string) {
 // Forbidden sends a forbidden response
 func Forbidden(c *gin.Context, message string) {
 	Error(c, http.StatusForbidden, message, nil)
-} 
\ No newline at end of file
+} 
+
+// TooManyRequests sends a too many requests response, telling the client how long to
+// wait with the Retry-After header
+func TooManyRequests(c *gin.Context, message string, retryAfter time.Duration) {
+	c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	Error(c, http.StatusTooManyRequests, message, nil)
+}
+
+// Created sends a successful response for a created resource
+func Created(c *gin.Context, data interface{}) {
+	render(c, http.StatusCreated, APIResponse{
+		Success: true,
+		Data:    data,
+	})
+}
+
+// Unavailable sends an unsuccessful response carrying data with 503 Service Unavailable,
+// for reports such as a failed readiness check
+func Unavailable(c *gin.Context, data interface{}) {
+	render(c, http.StatusServiceUnavailable, APIResponse{
+		Success: false,
+		Data:    data,
+	})
+}
+
+// render sends a JSON response, traced in a span as encoding large responses takes time
+func render(c *gin.Context, statusCode int, body APIResponse) {
+	_, span := tracer.Start(c.Request.Context(), "response.encode")
+	defer span.End()
+
+	c.JSON(statusCode, body)
+}
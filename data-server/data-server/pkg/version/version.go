@@ -0,0 +1,59 @@
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Version, Commit and BuildTime describe the build, they are set when building with
+// -ldflags "-X data-server/pkg/version.Version=v1.2.3 -X data-server/pkg/version.Commit=..."
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildTime = ""
+)
+
+// Info represents the build of the running server
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the build of the running server. The commit and build time not set at
+// build time are taken from the version control information embedded by the Go
+// toolchain, when the binary was built from a repository
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	revision, modified := "", false
+	for _, setting := range build.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value == "true"
+		case "vcs.time":
+			if info.BuildTime == "" {
+				info.BuildTime = setting.Value
+			}
+		}
+	}
+	if info.Commit == "" && revision != "" {
+		info.Commit = revision
+		if modified {
+			info.Commit += "-dirty"
+		}
+	}
+	return info
+}